@@ -4,14 +4,19 @@ set -eou pipefail
 echo "Step 1: Preparing revision folder...."
 
 # The revision folder includes"
-# 1) OpenAPI spec files that are currently in the repository are copied to the revision folder
+# 1) OpenAPI spec files that are currently in the repository are copied to the revision folder,
+#    including the preview, private preview and upcoming specs used by --include-previews
 # 2) changelog Metadata file that is generated via foascli
 # 3) exemptions.yaml file that is downloaded from S3 
 mkdir -p changelog/revision
 cp openapi/v2/openapi-*.json changelog/revision/
+if compgen -G "openapi/v2/private/openapi-*.json" > /dev/null; then
+  cp openapi/v2/private/openapi-*.json changelog/revision/
+fi
 
 echo "Generating revision metadata file"
-# We will revert this change once we add support for upcoming to changelog.
+# The metadata only lists the stable versions: the preview and upcoming changelogs are generated
+# from the openapi-<version>.json files with --include-previews.
 revision_version=$(< openapi/v2/versions.json jq -r '.[]' | paste -sd ',' - | sed "s/,preview//")
 RELEASE_SHA=$(< foas-metadata.json jq -r '.services[] | select(.name=="mms") | .sha')
 foascli changelog metadata create --sha "${RELEASE_SHA}" --versions="${revision_version}" > changelog/revision/metadata.json
@@ -25,12 +30,22 @@ echo "Step 1: Preparing revision folder - Done"
 echo "Step 2: Preparing base folder...."
 # The base folder includes"
 # 1) OpenAPI spec files that are downloaded from GH artifact in previous GH action step (release-spec.yml)
-# 2) Changelog files that are downloaded from GH artifact in previous GH action step (release-spec.yml)
+# 2) Changelog files that are downloaded from GH artifact in previous GH action step (release-spec.yml),
+#    including the preview and private preview changelogs in the preview and private-preview folders
 
 # Here we need to move the files downloaded from GH artifact to the structure expected by foascli changelog command
 mv changelog/base/openapi/v2/* changelog/base/
+if [[ -d changelog/base/private ]]; then
+  mv changelog/base/private/openapi-*.json changelog/base/
+  rm -rf changelog/base/private
+fi
 mv changelog/base/changelog/changelog.json changelog/base/
 mv changelog/base/changelog/internal/* changelog/base/
+for preview_folder in preview private-preview; do
+  if [[ -d "changelog/base/changelog/${preview_folder}" ]]; then
+    mv "changelog/base/changelog/${preview_folder}" changelog/base/
+  fi
+done
 
 echo "Step 2: Preparing base folder - Done"
 
@@ -41,7 +56,8 @@ echo "Listing revision folder files"
 ls -la changelog/revision/
 
 echo "Step 3: Generating changelog files...."
-foascli changelog generate -b changelog/base -r changelog/revision -e changelog/revision/exemptions.yaml -o changelog
+foascli changelog generate -b changelog/base -r changelog/revision -e changelog/revision/exemptions.yaml -s openapi/.raw/v2.json -o changelog \
+  --include-previews
 mv changelog/revision/metadata.json changelog/internal
 
 echo "Step 3: Generating changelog files - Done"
@@ -0,0 +1,142 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiversion
+
+import (
+	"log"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// VersionExtension declares the version of a content or, when the operation has no versioned content, of an operation.
+const VersionExtension = "x-xgen-version"
+
+// ContentVersion is the version of an operation content.
+// ContentType and Content are empty when the version is declared by the operation x-xgen-version extension.
+type ContentVersion struct {
+	Version     *APIVersion
+	ContentType string
+	Content     *openapi3.MediaType
+}
+
+// OperationContents returns the response contents and the request body content of the operation.
+func OperationContents(op *openapi3.Operation) []openapi3.Content {
+	contents := responseContents(op)
+	if content := requestBodyContent(op); content != nil {
+		contents = append(contents, content)
+	}
+
+	return contents
+}
+
+// responseContents returns the contents of the responses of the operation.
+func responseContents(op *openapi3.Operation) []openapi3.Content {
+	if op == nil || op.Responses == nil {
+		return nil
+	}
+
+	var contents []openapi3.Content
+	for _, response := range op.Responses.Map() {
+		if response == nil || response.Value == nil || response.Value.Content == nil {
+			continue
+		}
+		contents = append(contents, response.Value.Content)
+	}
+
+	return contents
+}
+
+// requestBodyContent returns the content of the request body of the operation, or nil.
+func requestBodyContent(op *openapi3.Operation) openapi3.Content {
+	if op == nil || op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil
+	}
+
+	return op.RequestBody.Value.Content
+}
+
+// OperationVersion returns the version declared by the x-xgen-version extension of the operation.
+func OperationVersion(op *openapi3.Operation) (*APIVersion, bool) {
+	if op == nil {
+		return nil, false
+	}
+
+	value, ok := op.Extensions[VersionExtension].(string)
+	if !ok {
+		return nil, false
+	}
+
+	version, err := New(WithVersion(value))
+	if err != nil {
+		log.Printf("Ignoring invalid %s extension %q for operation %q", VersionExtension, value, op.OperationID)
+		return nil, false
+	}
+
+	return version, true
+}
+
+// HasVersionedContent reports whether the response or request body contents of the operation have a versioned media type.
+func HasVersionedContent(op *openapi3.Operation) bool {
+	for _, content := range OperationContents(op) {
+		for contentType, contentValue := range content {
+			if _, err := New(WithFullContent(contentType, contentValue)); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ContentVersions returns the versions of the versioned media types of the contents.
+// Media types that are not versioned are ignored.
+func ContentVersions(contents ...openapi3.Content) []ContentVersion {
+	var versions []ContentVersion
+	for _, content := range contents {
+		for contentType, contentValue := range content {
+			if contentValue == nil {
+				continue
+			}
+
+			version, err := New(WithFullContent(contentType, contentValue))
+			if err != nil {
+				continue
+			}
+			versions = append(versions, ContentVersion{Version: version, ContentType: contentType, Content: contentValue})
+		}
+	}
+
+	return versions
+}
+
+// OperationContentVersions returns the versions of the response contents of the operation.
+// The versions of the request body content are only returned when no response content is versioned, since the
+// responses of the operation are filtered by the matched version.
+// When the operation has no versioned content, the version declared by its x-xgen-version extension is returned.
+func OperationContentVersions(op *openapi3.Operation) []ContentVersion {
+	if versions := ContentVersions(responseContents(op)...); len(versions) > 0 {
+		return versions
+	}
+
+	if versions := ContentVersions(requestBodyContent(op)); len(versions) > 0 {
+		return versions
+	}
+
+	if version, ok := OperationVersion(op); ok {
+		return []ContentVersion{{Version: version}}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiversion
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requestBodyOnlyOperation() *openapi3.Operation {
+	responses := &openapi3.Responses{}
+	responses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+
+	return &openapi3.Operation{
+		OperationID: "updateResource",
+		Responses:   responses,
+		RequestBody: &openapi3.RequestBodyRef{
+			Value: &openapi3.RequestBody{
+				Content: map[string]*openapi3.MediaType{
+					"application/vnd.atlas.2023-01-01+json": {},
+					"application/vnd.atlas.2024-05-30+json": {},
+				},
+			},
+		},
+	}
+}
+
+func extensionVersionedOperation(version string) *openapi3.Operation {
+	responses := &openapi3.Responses{}
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Content: map[string]*openapi3.MediaType{
+				"application/json": {},
+			},
+		},
+	})
+
+	return &openapi3.Operation{
+		OperationID: "getResource",
+		Responses:   responses,
+		Extensions:  map[string]any{VersionExtension: version},
+	}
+}
+
+func TestOperationContents(t *testing.T) {
+	assert.Len(t, OperationContents(requestBodyOnlyOperation()), 1)
+	assert.Len(t, OperationContents(oasOperationAllVersions()), 2)
+	assert.Empty(t, OperationContents(nil))
+}
+
+func TestOperationVersion(t *testing.T) {
+	version, ok := OperationVersion(extensionVersionedOperation("2024-01-01"))
+	require.True(t, ok)
+	assert.Equal(t, "2024-01-01", version.String())
+
+	_, ok = OperationVersion(extensionVersionedOperation("invalid"))
+	assert.False(t, ok)
+
+	_, ok = OperationVersion(requestBodyOnlyOperation())
+	assert.False(t, ok)
+}
+
+func TestHasVersionedContent(t *testing.T) {
+	assert.True(t, HasVersionedContent(requestBodyOnlyOperation()))
+	assert.True(t, HasVersionedContent(oasOperationAllVersions()))
+	assert.False(t, HasVersionedContent(extensionVersionedOperation("2024-01-01")))
+}
+
+func TestContentVersions(t *testing.T) {
+	versions := ContentVersions(OperationContents(requestBodyOnlyOperation())...)
+	require.Len(t, versions, 2)
+	for _, v := range versions {
+		assert.NotNil(t, v.Content)
+	}
+
+	assert.Empty(t, ContentVersions(OperationContents(extensionVersionedOperation("2024-01-01"))...))
+	assert.Empty(t, ContentVersions())
+}
+
+func TestFindLatestContentVersionMatched_RequestBodyOnly(t *testing.T) {
+	testCases := []struct {
+		targetVersion string
+		expectedMatch string
+	}{
+		{targetVersion: "2023-06-01", expectedMatch: "2023-01-01"},
+		{targetVersion: "2024-05-30", expectedMatch: "2024-05-30"},
+		{targetVersion: "2025-01-01", expectedMatch: "2024-05-30"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.targetVersion, func(t *testing.T) {
+			targetVersion, err := New(WithVersion(tt.targetVersion))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMatch, FindLatestContentVersionMatched(requestBodyOnlyOperation(), targetVersion).String())
+		})
+	}
+}
+
+func TestFindLatestContentVersionMatched_RequestBodyAndResponses(t *testing.T) {
+	op := requestBodyOnlyOperation()
+	op.Responses.Set("200", &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Content: map[string]*openapi3.MediaType{
+				"application/vnd.atlas.2023-06-01+json": {},
+			},
+		},
+	})
+
+	// The request body versions are ignored when the responses are versioned
+	targetVersion, err := New(WithVersion("2025-01-01"))
+	require.NoError(t, err)
+	assert.Equal(t, "2023-06-01", FindLatestContentVersionMatched(op, targetVersion).String())
+}
+
+func TestFindLatestContentVersionMatched_VersionExtension(t *testing.T) {
+	testCases := []struct {
+		targetVersion string
+		expectedMatch string
+	}{
+		{targetVersion: "2023-06-01", expectedMatch: "2023-06-01"},
+		{targetVersion: "2024-01-01", expectedMatch: "2024-01-01"},
+		{targetVersion: "2025-01-01", expectedMatch: "2024-01-01"},
+		{targetVersion: "2025-01-01.upcoming", expectedMatch: "2025-01-01.upcoming"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.targetVersion, func(t *testing.T) {
+			targetVersion, err := New(WithVersion(tt.targetVersion))
+			require.NoError(t, err)
+			r := FindLatestContentVersionMatched(extensionVersionedOperation("2024-01-01"), targetVersion)
+			assert.Equal(t, tt.expectedMatch, r.String())
+		})
+	}
+}
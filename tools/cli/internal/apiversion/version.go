@@ -212,6 +212,8 @@ func Parse(contentType string) (string, error) {
 }
 
 // FindLatestContentVersionMatched finds the latest content version that matches the requested version.
+// The request body contents are only considered when the responses are not versioned, so that operations versioned
+// only in their request body are matched. Operations without versioned contents are matched by their x-xgen-version extension.
 func FindLatestContentVersionMatched(op *openapi3.Operation, requestedVersion *APIVersion) *APIVersion {
 	/*
 		  given:
@@ -226,41 +228,25 @@ func FindLatestContentVersionMatched(op *openapi3.Operation, requestedVersion *A
 		  should return latestVersionMatch=2023-12-01
 	*/
 	var latestVersionMatch *APIVersion
-	if op.Responses == nil {
-		return requestedVersion
-	}
-
-	for _, response := range op.Responses.Map() {
-		if response.Value == nil || response.Value.Content == nil {
-			continue
+	for _, c := range OperationContentVersions(op) {
+		if c.Version.Equal(requestedVersion) {
+			return c.Version
 		}
 
-		for contentType, contentValue := range response.Value.Content {
-			contentVersion, err := New(WithFullContent(contentType, contentValue))
-			if err != nil {
-				log.Printf("Ignoring invalid content type: %q", contentType)
-				continue
-			}
-
-			if contentVersion.Equal(requestedVersion) {
-				return contentVersion
-			}
-
-			if requestedVersion.ExactMatchOnly() {
-				// for private preview, we will need to match with "preview" and x-xgen-preview name extension
-				if privatePreviewContentMatch(contentVersion, contentValue, requestedVersion) {
-					return contentVersion
-				}
-				continue
+		if requestedVersion.ExactMatchOnly() {
+			// for private preview, we will need to match with "preview" and x-xgen-preview name extension
+			if c.Content != nil && privatePreviewContentMatch(c.Version, c.Content, requestedVersion) {
+				return c.Version
 			}
+			continue
+		}
 
-			if contentVersion.GreaterThan(requestedVersion) {
-				continue
-			}
+		if c.Version.GreaterThan(requestedVersion) {
+			continue
+		}
 
-			if latestVersionMatch == nil || contentVersion.GreaterThan(latestVersionMatch) {
-				latestVersionMatch = contentVersion
-			}
+		if latestVersionMatch == nil || c.Version.GreaterThan(latestVersionMatch) {
+			latestVersionMatch = c.Version
 		}
 	}
 
@@ -0,0 +1,72 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"errors"
+	"log"
+)
+
+// Snapshot is a spec snapshot used to backfill the changelog.
+type Snapshot struct {
+	// Path is the folder with the metadata.json and openapi-<version>.json files of the snapshot.
+	Path string
+	// RunDate is the date when the changelog is generated for the snapshot. (Format: YYYY-MM-DD)
+	RunDate string
+}
+
+// NewBackfillEntries generates the changelog from scratch as if it had been generated at the run date of each snapshot.
+// The snapshots must be sorted by run date. The changelog is generated pairwise, using the changelog without
+// the hidden changes of the previous pair as base changelog, like the daily changelog generation does.
+// The returned entries include the hidden changes of the last run date.
+func NewBackfillEntries(snapshots []*Snapshot, exceptionFilePath string) ([]*Entry, error) {
+	if len(snapshots) < 2 {
+		return nil, errors.New("at least two snapshots are required to backfill the changelog")
+	}
+
+	baseChangelog := []*Entry{}
+	var entries []*Entry
+	for i := 1; i < len(snapshots); i++ {
+		base, revision := snapshots[i-1], snapshots[i]
+		log.Printf("Backfilling the changelog between %s (%s) and %s (%s)", base.Path, base.RunDate, revision.Path, revision.RunDate)
+
+		baseMetadata, err := newMetadataFromFile(base.Path)
+		if err != nil {
+			return nil, err
+		}
+		baseMetadata.RunDate = base.RunDate
+
+		revisionMetadata, err := newMetadataFromFile(revision.Path)
+		if err != nil {
+			return nil, err
+		}
+		revisionMetadata.RunDate = revision.RunDate
+
+		entries, err = newEntries(baseMetadata, revisionMetadata, exceptionFilePath, baseChangelog)
+		if err != nil {
+			return nil, err
+		}
+
+		baseChangelog, err = NewNotHiddenEntries(entries)
+		if err != nil {
+			return nil, err
+		}
+		if baseChangelog == nil {
+			baseChangelog = []*Entry{}
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackfillEntries(t *testing.T) {
+	testPath := "../../test/data/changelog/new-api-version"
+	snapshots := []*Snapshot{
+		{Path: testPath + "/base", RunDate: "2024-08-07"},
+		{Path: testPath + "/revision", RunDate: "2024-08-08"},
+	}
+
+	entries, err := NewBackfillEntries(snapshots, testPath+"/exemptions.yaml")
+	require.NoError(t, err)
+
+	// the incremental changelog has the same entry for the revision run date
+	expected, err := NewEntriesFromPath(testPath + "/output/changelog-all.json")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, expected[0].Date, entries[0].Date)
+	require.Len(t, entries[0].Paths, len(expected[0].Paths))
+	for i, path := range entries[0].Paths {
+		expectedPath := expected[0].Paths[i]
+		assert.Equal(t, expectedPath.OperationID, path.OperationID)
+		require.Len(t, path.Versions, len(expectedPath.Versions))
+		for j, version := range path.Versions {
+			assert.Equal(t, expectedPath.Versions[j].Version, version.Version)
+			assert.ElementsMatch(t, expectedPath.Versions[j].Changes, version.Changes)
+		}
+	}
+}
+
+func TestNewBackfillEntries_NotEnoughSnapshots(t *testing.T) {
+	_, err := NewBackfillEntries([]*Snapshot{{Path: "snapshot", RunDate: "2024-08-07"}}, "exemptions.yaml")
+	require.EqualError(t, err, "at least two snapshots are required to backfill the changelog")
+}
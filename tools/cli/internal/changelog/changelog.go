@@ -85,6 +85,7 @@ type Path struct {
 	Versions       []*Version `json:"versions,omitempty"`
 	OperationID    string     `json:"operationId"`
 	Tag            string     `json:"tag"`
+	OwnerTeam      string     `json:"ownerTeam,omitempty"`
 	StabilityLevel string     `json:"stabilityLevel,omitempty"`
 	ChangeType     string     `json:"changeType,omitempty"`
 	Changes        []*Change  `json:"changes,omitempty"`
@@ -127,6 +128,12 @@ func NewEntriesWithRunDate(basePath, revisionPath, exceptionFilePath, runDate st
 
 	revisionMetadata.RunDate = runDate
 
+	return newEntries(baseMetadata, revisionMetadata, exceptionFilePath, nil)
+}
+
+// newEntries generates the changelog entries between the base and revision metadata specs.
+// The base changelog is read from the base folder if baseChangelog is nil.
+func newEntries(baseMetadata, revisionMetadata *Metadata, exceptionFilePath string, baseChangelog []*Entry) ([]*Entry, error) {
 	baseActiveVersionOnPreviousRunDate, err := latestVersionActiveOnDate(baseMetadata.RunDate, baseMetadata.Versions)
 	if err != nil {
 		return nil, err
@@ -156,7 +163,7 @@ func NewEntriesWithRunDate(basePath, revisionPath, exceptionFilePath, runDate st
 	baseMetadata.ActiveVersion = baseActiveVersionOnPreviousRunDate
 	revisionMetadata.ActiveVersion = revisionActiveVersionOnPreviousRunDate
 
-	changelog, err := newChangelog(baseMetadata, revisionMetadata, exceptionFilePath, nil)
+	changelog, err := newChangelog(baseMetadata, revisionMetadata, exceptionFilePath, baseChangelog)
 	if err != nil {
 		return nil, err
 	}
@@ -563,6 +570,13 @@ func NewNotHiddenEntries(changelog []*Entry) ([]*Entry, error) {
 		return nil, err
 	}
 
+	// The owner teams are internal and only included in the changelog with the hidden changes
+	for _, entry := range changelog {
+		for _, path := range entry.Paths {
+			path.OwnerTeam = ""
+		}
+	}
+
 	// Get changes only for the last date, which is what was recently merged
 	changes := changelog[0]
 
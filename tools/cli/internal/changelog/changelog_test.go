@@ -42,6 +42,7 @@ func TestNewNotHiddenEntries(t *testing.T) {
 							URI:         "/api/v1/test",
 							HTTPMethod:  "GET",
 							OperationID: "getTest",
+							OwnerTeam:   "Atlas Dedicated",
 							Versions: []*Version{
 								{
 									Version:        "v1",
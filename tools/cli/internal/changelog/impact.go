@@ -0,0 +1,209 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+)
+
+const (
+	ImpactBreaking    = "breaking"
+	ImpactDeprecation = "deprecation"
+	ImpactSunset      = "sunset"
+)
+
+// Impact is a change affecting an operation called with a pinned API version.
+type Impact struct {
+	Date        string `json:"date"`
+	Kind        string `json:"kind"`
+	OperationID string `json:"operationId"`
+	Path        string `json:"path"`
+	HTTPMethod  string `json:"httpMethod"`
+	Tag         string `json:"tag"`
+	// Version is the resource version of the operation served for the pinned API version.
+	Version    string `json:"version"`
+	ChangeCode string `json:"changeCode,omitempty"`
+	Change     string `json:"change"`
+}
+
+// ImpactReport lists the changes affecting the operations called with a pinned API version.
+type ImpactReport struct {
+	Version string    `json:"version"`
+	Since   string    `json:"since,omitempty"`
+	Impacts []*Impact `json:"impacts"`
+	// UnavailableOperationIDs are the operations not available in the spec of the pinned API version.
+	UnavailableOperationIDs []string `json:"unavailableOperationIds,omitempty"`
+}
+
+// NewImpactReport returns the changes of the changelog affecting the operations called with the API version of the spec:
+// the breaking changes and deprecations of the resource version served for each operation since the date, and their
+// sunset dates on or after the date. The hidden changes are skipped. The impacts are sorted by date DESC and operationId.
+func NewImpactReport(entries []*Entry, spec *openapi3.T, version string, operationIDs []string, since string) *ImpactReport {
+	report := &ImpactReport{
+		Version: version,
+		Since:   since,
+		Impacts: make([]*Impact, 0),
+	}
+
+	operations := newImpactOperations(spec, version)
+	for _, operationID := range operationIDs {
+		operation, ok := operations[operationID]
+		if !ok {
+			report.UnavailableOperationIDs = append(report.UnavailableOperationIDs, operationID)
+			continue
+		}
+
+		report.Impacts = append(report.Impacts, operation.newImpactsFromEntries(entries, since)...)
+		if operation.sunset != "" && operation.sunset >= since {
+			report.Impacts = append(report.Impacts, operation.newImpact(operation.sunset, ImpactSunset, &Change{
+				Description: "The resource version " + operation.version + " is marked for removal on " + operation.sunset,
+			}))
+		}
+	}
+
+	sort.SliceStable(report.Impacts, func(i, j int) bool {
+		if report.Impacts[i].Date != report.Impacts[j].Date {
+			return report.Impacts[i].Date > report.Impacts[j].Date
+		}
+		return report.Impacts[i].OperationID < report.Impacts[j].OperationID
+	})
+
+	return report
+}
+
+// impactOperation is an operation of the spec with the resource version served for the pinned API version.
+type impactOperation struct {
+	path       string
+	httpMethod string
+	tag        string
+	version    string
+	sunset     string
+	operation  *openapi3.Operation
+}
+
+func newImpactOperations(spec *openapi3.T, version string) map[string]*impactOperation {
+	operations := make(map[string]*impactOperation)
+	if spec == nil || spec.Paths == nil {
+		return operations
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for httpMethod, operation := range pathItem.Operations() {
+			if operation.OperationID == "" {
+				continue
+			}
+
+			o := &impactOperation{
+				path:       path,
+				httpMethod: httpMethod,
+				version:    resourceVersion(operation, version),
+				operation:  operation,
+			}
+			if len(operation.Tags) > 0 {
+				o.tag = operation.Tags[0]
+			}
+			if sunset, ok := operation.Extensions["x-sunset"].(string); ok {
+				o.sunset = sunset
+			}
+			operations[operation.OperationID] = o
+		}
+	}
+
+	return operations
+}
+
+// resourceVersion returns the latest version of the operation contents, see apiversion.OperationContentVersions,
+// or the API version if the operation is not versioned.
+func resourceVersion(operation *openapi3.Operation, version string) string {
+	contentVersions := apiversion.OperationContentVersions(operation)
+	if len(contentVersions) == 0 {
+		return version
+	}
+
+	versions := make([]*apiversion.APIVersion, 0, len(contentVersions))
+	for _, contentVersion := range contentVersions {
+		versions = append(versions, contentVersion.Version)
+	}
+
+	// Sort sorts in descending order, so the latest version is the first one
+	apiversion.Sort(versions)
+	return versions[0].String()
+}
+
+func (o *impactOperation) newImpactsFromEntries(entries []*Entry, since string) []*Impact {
+	impacts := make([]*Impact, 0)
+	for _, entry := range entries {
+		if entry.Date < since {
+			continue
+		}
+
+		for _, path := range entry.Paths {
+			if path.OperationID != o.operation.OperationID {
+				continue
+			}
+
+			for _, version := range path.Versions {
+				if version.Version != o.version {
+					continue
+				}
+
+				for _, change := range version.Changes {
+					if kind := impactKind(change); kind != "" {
+						impacts = append(impacts, o.newImpact(entry.Date, kind, change))
+					}
+				}
+			}
+		}
+	}
+
+	return impacts
+}
+
+func (o *impactOperation) newImpact(date, kind string, change *Change) *Impact {
+	return &Impact{
+		Date:        date,
+		Kind:        kind,
+		OperationID: o.operation.OperationID,
+		Path:        o.path,
+		HTTPMethod:  o.httpMethod,
+		Tag:         o.tag,
+		Version:     o.version,
+		ChangeCode:  change.Code,
+		Change:      change.Description,
+	}
+}
+
+// impactKind returns the kind of impact of the change, or an empty string if the change doesn't affect the clients.
+func impactKind(change *Change) string {
+	switch {
+	case change.HideFromChangelog:
+		return ""
+	case isDeprecation(change.Code):
+		return ImpactDeprecation
+	case !change.BackwardCompatible:
+		return ImpactBreaking
+	default:
+		return ""
+	}
+}
+
+// isDeprecation reports whether the change code is a deprecation, e.g. endpoint-deprecated or request-parameter-deprecated.
+func isDeprecation(code string) bool {
+	return strings.HasSuffix(code, "-deprecated") && !strings.HasSuffix(code, "-not-deprecated")
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newImpactSpec() *openapi3.T {
+	versionedResponses := func(version string) *openapi3.Responses {
+		content := openapi3.Content{
+			"application/vnd.atlas." + version + "+json": &openapi3.MediaType{
+				Extensions: map[string]any{"x-xgen-version": version},
+			},
+		}
+		return openapi3.NewResponses(openapi3.WithStatus(200, &openapi3.ResponseRef{Value: &openapi3.Response{Content: content}}))
+	}
+
+	return &openapi3.T{
+		Paths: openapi3.NewPaths(
+			openapi3.WithPath("/api/atlas/v2/groups/{groupId}/clusters", &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					OperationID: "listClusters",
+					Tags:        []string{"Clusters"},
+					Responses:   versionedResponses("2023-02-01"),
+					Extensions:  map[string]any{"x-sunset": "2026-03-01"},
+				},
+			}),
+			openapi3.WithPath("/api/atlas/v2/groups/{groupId}/clusters/{clusterName}", &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					OperationID: "getCluster",
+					Tags:        []string{"Clusters"},
+					Responses:   versionedResponses("2024-08-05"),
+				},
+			}),
+		),
+	}
+}
+
+func TestNewImpactReport(t *testing.T) {
+	entries := []*Entry{
+		{
+			Date: "2025-04-01",
+			Paths: []*Path{
+				{
+					OperationID: "listClusters",
+					Versions: []*Version{
+						{
+							Version: "2023-02-01",
+							Changes: []*Change{
+								{Code: "endpoint-deprecated", Description: "New resource added 2024-08-05. Resource version 2023-02-01 deprecated"},
+								{Code: "response-optional-property-removed", Description: "removed the optional property 'name'"},
+								{Code: "response-optional-property-added", Description: "added the optional property 'id'", BackwardCompatible: true},
+								{Code: "request-property-removed", Description: "removed the request property 'id'", HideFromChangelog: true},
+							},
+						},
+						{
+							Version: "2024-08-05",
+							Changes: []*Change{{Code: "endpoint-added", Description: "added the endpoint", BackwardCompatible: true}},
+						},
+					},
+				},
+				{
+					OperationID: "getCluster",
+					Versions: []*Version{
+						{
+							Version: "2024-08-05",
+							Changes: []*Change{{Code: "request-parameter-became-not-deprecated", BackwardCompatible: true}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date: "2024-12-01",
+			Paths: []*Path{
+				{
+					OperationID: "getCluster",
+					Versions: []*Version{
+						{Version: "2024-08-05", Changes: []*Change{{Code: "response-property-type-changed", Description: "changed the type"}}},
+					},
+				},
+			},
+		},
+	}
+
+	report := NewImpactReport(entries, newImpactSpec(), "2024-05-30", []string{"listClusters", "getCluster", "deleteCluster"}, "2025-01-01")
+
+	assert.Equal(t, &ImpactReport{
+		Version: "2024-05-30",
+		Since:   "2025-01-01",
+		Impacts: []*Impact{
+			{
+				Date:        "2026-03-01",
+				Kind:        ImpactSunset,
+				OperationID: "listClusters",
+				Path:        "/api/atlas/v2/groups/{groupId}/clusters",
+				HTTPMethod:  "GET",
+				Tag:         "Clusters",
+				Version:     "2023-02-01",
+				Change:      "The resource version 2023-02-01 is marked for removal on 2026-03-01",
+			},
+			{
+				Date:        "2025-04-01",
+				Kind:        ImpactDeprecation,
+				OperationID: "listClusters",
+				Path:        "/api/atlas/v2/groups/{groupId}/clusters",
+				HTTPMethod:  "GET",
+				Tag:         "Clusters",
+				Version:     "2023-02-01",
+				ChangeCode:  "endpoint-deprecated",
+				Change:      "New resource added 2024-08-05. Resource version 2023-02-01 deprecated",
+			},
+			{
+				Date:        "2025-04-01",
+				Kind:        ImpactBreaking,
+				OperationID: "listClusters",
+				Path:        "/api/atlas/v2/groups/{groupId}/clusters",
+				HTTPMethod:  "GET",
+				Tag:         "Clusters",
+				Version:     "2023-02-01",
+				ChangeCode:  "response-optional-property-removed",
+				Change:      "removed the optional property 'name'",
+			},
+		},
+		UnavailableOperationIDs: []string{"deleteCluster"},
+	}, report)
+}
+
+func TestNewImpactReport_WithoutSince(t *testing.T) {
+	entries := []*Entry{
+		{
+			Date: "2024-12-01",
+			Paths: []*Path{
+				{
+					OperationID: "getCluster",
+					Versions: []*Version{
+						{Version: "2024-08-05", Changes: []*Change{{Code: "response-property-type-changed", Description: "changed the type"}}},
+					},
+				},
+			},
+		},
+	}
+
+	report := NewImpactReport(entries, newImpactSpec(), "2024-08-05", []string{"getCluster"}, "")
+
+	assert.Empty(t, report.UnavailableOperationIDs)
+	assert.Len(t, report.Impacts, 1)
+	assert.Equal(t, ImpactBreaking, report.Impacts[0].Kind)
+	assert.Equal(t, "2024-12-01", report.Impacts[0].Date)
+}
+
+func TestIsDeprecation(t *testing.T) {
+	assert.True(t, isDeprecation("endpoint-deprecated"))
+	assert.True(t, isDeprecation("request-parameter-deprecated"))
+	assert.False(t, isDeprecation("request-parameter-became-not-deprecated"))
+	assert.False(t, isDeprecation("endpoint-added"))
+}
+
+func TestResourceVersion(t *testing.T) {
+	content := func(versions ...string) openapi3.Content {
+		c := openapi3.Content{}
+		for _, version := range versions {
+			c["application/vnd.atlas."+version+"+json"] = &openapi3.MediaType{}
+		}
+		return c
+	}
+
+	testCases := []struct {
+		name      string
+		operation *openapi3.Operation
+		expected  string
+	}{
+		{
+			name: "latest response version",
+			operation: &openapi3.Operation{
+				Responses: openapi3.NewResponses(
+					openapi3.WithStatus(200, &openapi3.ResponseRef{Value: &openapi3.Response{Content: content("2023-02-01")}}),
+					openapi3.WithStatus(201, &openapi3.ResponseRef{Value: &openapi3.Response{Content: content("2023-01-01", "2024-08-05")}}),
+				),
+			},
+			expected: "2024-08-05",
+		},
+		{
+			name: "request body version",
+			operation: &openapi3.Operation{
+				RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{Content: content("2023-02-01")}},
+				Responses:   openapi3.NewResponses(),
+			},
+			expected: "2023-02-01",
+		},
+		{
+			name:      "not versioned",
+			operation: &openapi3.Operation{Responses: openapi3.NewResponses()},
+			expected:  "2024-05-30",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for range 10 {
+				assert.Equal(t, tc.expected, resourceVersion(tc.operation, "2024-05-30"))
+			}
+		})
+	}
+}
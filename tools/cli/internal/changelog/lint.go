@@ -0,0 +1,174 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Rules reported by the changelog linter.
+const (
+	InvalidDateRule      = "invalid-date"
+	UnsortedDatesRule    = "unsorted-dates"
+	DuplicateDateRule    = "duplicate-date"
+	DuplicateChangeRule  = "duplicate-change"
+	UnknownVersionRule   = "unknown-version"
+	UnknownOperationRule = "unknown-operation"
+)
+
+// Violation is an integrity issue of the changelog.
+type Violation struct {
+	Rule string `json:"rule" yaml:"rule"`
+	// Pointer is the JSON pointer of the offending value in the changelog file, e.g. /3/paths/0/versions/1/changes/2.
+	Pointer string `json:"pointer" yaml:"pointer"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Lint verifies the integrity of the changelog entries against the metadata versions and the current spec.
+// The entries must be sorted by date in descending order without duplicated dates, as sortChangelog does.
+// Within an entry, the same change must not be reported twice for the same path, method and version.
+// The changes are compared by change code and description, since a change code is reported once per property.
+// The versions must be in the metadata, and the operations must be in the spec or have an endpoint-removed change.
+func Lint(entries []*Entry, metadata *Metadata, spec *openapi3.T) []*Violation {
+	l := &changelogLinter{
+		versions:     metadata.Versions,
+		operationIDs: newOperationIDs(spec),
+	}
+
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			if slices.ContainsFunc(path.Versions, hasEndpointRemovedChange) || slices.ContainsFunc(path.Changes, isEndpointRemoved) {
+				l.operationIDs[path.OperationID] = true
+			}
+		}
+	}
+
+	dates := make(map[string]int)
+	for i, entry := range entries {
+		entryPointer := "/" + strconv.Itoa(i)
+		l.lintDate(entries, i)
+		if first, ok := dates[entry.Date]; ok {
+			l.add(DuplicateDateRule, entryPointer+"/date", "date %s is also used by the entry /%d", entry.Date, first)
+		} else {
+			dates[entry.Date] = i
+		}
+
+		l.lintEntry(entryPointer, entry)
+	}
+
+	return l.violations
+}
+
+type changelogLinter struct {
+	versions     []string
+	operationIDs map[string]bool
+	violations   []*Violation
+}
+
+func (l *changelogLinter) lintDate(entries []*Entry, i int) {
+	datePointer := fmt.Sprintf("/%d/date", i)
+	if _, err := time.Parse(time.DateOnly, entries[i].Date); err != nil {
+		l.add(InvalidDateRule, datePointer, "date %q is not in the format YYYY-MM-DD", entries[i].Date)
+		return
+	}
+
+	if i > 0 && entries[i].Date > entries[i-1].Date {
+		l.add(UnsortedDatesRule, datePointer, "date %s is after the date %s of the previous entry, the entries must be sorted by date in descending order",
+			entries[i].Date, entries[i-1].Date)
+	}
+}
+
+func (l *changelogLinter) lintEntry(entryPointer string, entry *Entry) {
+	if entry.FromVersion != "" {
+		l.lintVersion(entryPointer+"/fromVersion", entry.FromVersion, "from version "+entry.FromVersion)
+	}
+	if entry.ToVersion != "" {
+		l.lintVersion(entryPointer+"/toVersion", entry.ToVersion, "to version "+entry.ToVersion)
+	}
+
+	// changes holds the pointer of the first occurrence of each change in the entry
+	changes := make(map[string]string)
+	for j, path := range entry.Paths {
+		pathPointer := fmt.Sprintf("%s/paths/%d", entryPointer, j)
+		operation := path.HTTPMethod + " " + path.URI
+		if !l.operationIDs[path.OperationID] {
+			l.add(UnknownOperationRule, pathPointer+"/operationId",
+				"operation %s of %s is not in the spec and has no %s change", path.OperationID, operation, endpointRemovedCode)
+		}
+
+		for k, version := range path.Versions {
+			versionPointer := fmt.Sprintf("%s/versions/%d", pathPointer, k)
+			l.lintVersion(versionPointer+"/version", version.Version, fmt.Sprintf("version %s of %s", version.Version, operation))
+			l.lintChanges(changes, versionPointer, operation, version.Version, version.Changes)
+		}
+
+		l.lintChanges(changes, pathPointer, operation, entry.ToVersion, path.Changes)
+	}
+}
+
+func (l *changelogLinter) lintVersion(versionPointer, version, description string) {
+	if !slices.Contains(l.versions, version) {
+		l.add(UnknownVersionRule, versionPointer, "%s is not in the metadata versions [%s]", description, strings.Join(l.versions, ", "))
+	}
+}
+
+func (l *changelogLinter) lintChanges(changes map[string]string, parentPointer, operation, version string, versionChanges []*Change) {
+	for i, change := range versionChanges {
+		changePointer := fmt.Sprintf("%s/changes/%d", parentPointer, i)
+		key := strings.Join([]string{operation, version, change.Code, change.Description}, "\n")
+		if first, ok := changes[key]; ok {
+			l.add(DuplicateChangeRule, changePointer, "change %s of %s version %s is already reported at %s",
+				change.Code, operation, version, first)
+			continue
+		}
+		changes[key] = changePointer
+	}
+}
+
+func (l *changelogLinter) add(rule, ptr, format string, args ...any) {
+	l.violations = append(l.violations, &Violation{
+		Rule:    rule,
+		Pointer: ptr,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func newOperationIDs(spec *openapi3.T) map[string]bool {
+	operationIDs := make(map[string]bool)
+	if spec == nil || spec.Paths == nil {
+		return operationIDs
+	}
+
+	for _, pathItem := range spec.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			operationIDs[op.OperationID] = true
+		}
+	}
+	return operationIDs
+}
+
+func hasEndpointRemovedChange(version *Version) bool {
+	return slices.ContainsFunc(version.Changes, isEndpointRemoved)
+}
+
+func isEndpointRemoved(change *Change) bool {
+	return change.Code == endpointRemovedCode
+}
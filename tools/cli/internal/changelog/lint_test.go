@@ -0,0 +1,198 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLintSpec() *openapi3.T {
+	return &openapi3.T{
+		Paths: openapi3.NewPaths(
+			openapi3.WithPath("/api/atlas/v2/groups/{groupId}/clusters", &openapi3.PathItem{
+				Get:  &openapi3.Operation{OperationID: "listClusters"},
+				Post: &openapi3.Operation{OperationID: "createCluster"},
+			}),
+		),
+	}
+}
+
+func TestLint(t *testing.T) {
+	metadata := &Metadata{Versions: []string{"2023-01-01", "2024-08-05"}}
+	entries := []*Entry{
+		{
+			Date: "2025-01-10",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "GET",
+					OperationID: "listClusters",
+					Versions: []*Version{
+						{
+							Version: "2024-08-05",
+							Changes: []*Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+								{Description: "removed the property 'name'", Code: "response-property-removed"},
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+							},
+						},
+						{
+							Version: "2024-05-30",
+							Changes: []*Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+							},
+						},
+					},
+				},
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Versions: []*Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*Change{{Description: "added the property 'id'", Code: "response-optional-property-added"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date: "2025-02-01",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/backup",
+					HTTPMethod:  "DELETE",
+					OperationID: "deleteBackup",
+					Versions: []*Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*Change{{Description: "endpoint removed", Code: "endpoint-removed"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date: "2025-01-10",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/backup",
+					HTTPMethod:  "DELETE",
+					OperationID: "deleteBackup",
+					Versions: []*Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*Change{{Description: "added the parameter 'force'", Code: "new-optional-request-parameter"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date: "Jan 1",
+		},
+	}
+
+	expected := []*Violation{
+		{
+			Rule:    DuplicateChangeRule,
+			Pointer: "/0/paths/0/versions/0/changes/2",
+			Message: "change response-property-removed of GET /api/atlas/v2/groups/{groupId}/clusters version 2024-08-05 is already reported at /0/paths/0/versions/0/changes/0", //nolint:lll // full message
+		},
+		{
+			Rule:    UnknownVersionRule,
+			Pointer: "/0/paths/0/versions/1/version",
+			Message: "version 2024-05-30 of GET /api/atlas/v2/groups/{groupId}/clusters is not in the metadata versions [2023-01-01, 2024-08-05]",
+		},
+		{
+			Rule:    UnknownOperationRule,
+			Pointer: "/0/paths/1/operationId",
+			Message: "operation listProjects of GET /api/atlas/v2/groups is not in the spec and has no endpoint-removed change",
+		},
+		{
+			Rule:    UnsortedDatesRule,
+			Pointer: "/1/date",
+			Message: "date 2025-02-01 is after the date 2025-01-10 of the previous entry, the entries must be sorted by date in descending order",
+		},
+		{
+			Rule:    DuplicateDateRule,
+			Pointer: "/2/date",
+			Message: "date 2025-01-10 is also used by the entry /0",
+		},
+		{
+			Rule:    InvalidDateRule,
+			Pointer: "/3/date",
+			Message: `date "Jan 1" is not in the format YYYY-MM-DD`,
+		},
+	}
+
+	assert.Equal(t, expected, Lint(entries, metadata, newLintSpec()))
+}
+
+func TestLint_VersionDiff(t *testing.T) {
+	metadata := &Metadata{Versions: []string{"2023-01-01", "2024-08-05"}}
+	entries := []*Entry{
+		{
+			Date:        "2025-01-10",
+			FromVersion: "2023-01-01",
+			ToVersion:   "2024-05-30",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "POST",
+					OperationID: "createCluster",
+					Changes: []*Change{
+						{Description: "removed the request property 'id'", Code: "request-property-removed"},
+						{Description: "removed the request property 'id'", Code: "request-property-removed"},
+					},
+				},
+			},
+		},
+	}
+
+	violations := Lint(entries, metadata, newLintSpec())
+	assert.Equal(t, []*Violation{
+		{
+			Rule:    UnknownVersionRule,
+			Pointer: "/0/toVersion",
+			Message: "to version 2024-05-30 is not in the metadata versions [2023-01-01, 2024-08-05]",
+		},
+		{
+			Rule:    DuplicateChangeRule,
+			Pointer: "/0/paths/0/changes/1",
+			Message: "change request-property-removed of POST /api/atlas/v2/groups/{groupId}/clusters version 2024-05-30 is already reported at /0/paths/0/changes/0", //nolint:lll // full message
+		},
+	}, violations)
+}
+
+func TestLint_Valid(t *testing.T) {
+	entries, err := NewEntriesFromPath("../../test/data/changelog/changelog.json")
+	require.NoError(t, err)
+
+	spec := &openapi3.T{Paths: openapi3.NewPaths()}
+	for _, operationID := range []string{
+		"listClusters", "createCluster", "deleteCluster", "getCluster", "updateCluster", "getManagedNamespace", "deleteAllCustomZoneMappings",
+		"createCustomZoneMapping", "deleteManagedNamespace", "createManagedNamespace", "testFailover", "getHostLogs",
+	} {
+		spec.Paths.Set("/"+operationID, &openapi3.PathItem{Get: &openapi3.Operation{OperationID: operationID}})
+	}
+
+	assert.Empty(t, Lint(entries, &Metadata{Versions: []string{"2023-01-01", "2023-02-01"}}, spec))
+}
@@ -261,6 +261,7 @@ func newMergedChanges(changes []*outputfilter.OasDiffEntry,
 
 		pathEntry.OperationID = operationID
 		pathEntry.Tag = conf.Tag()
+		pathEntry.OwnerTeam = conf.OwnerTeam()
 
 		pathEntryVersion := newEntryVersion(&pathEntry.Versions, version)
 		pathEntryVersion.StabilityLevel = stabilityLevelStable
@@ -327,12 +328,10 @@ func newDeprecatedChangeEntry(
 	}()
 
 	return &outputfilter.OasDiffEntry{
-		ID:          endpointDeprecatedCode,
-		Operation:   change.Operation,
-		OperationID: change.OperationID,
-		Text: fmt.Sprintf(
-			"New resource added %s. Resource version %s deprecated and marked for removal on %s",
-			revisionVersion, baseVersion, baseVersionSunset),
+		ID:                endpointDeprecatedCode,
+		Operation:         change.Operation,
+		OperationID:       change.OperationID,
+		Text:              outputfilter.Localize(endpointDeprecatedCode, revisionVersion, baseVersion, baseVersionSunset),
 		Level:             change.Level,
 		Path:              change.Path,
 		HideFromChangelog: change.HideFromChangelog,
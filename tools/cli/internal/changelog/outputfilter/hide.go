@@ -22,15 +22,18 @@ var hideIDs = []string{
 }
 
 // MarkHiddenEntries sets the HideFromChangelog flag to true.
+// The exemptions are skipped when exemptionsFilePath is empty.
 func MarkHiddenEntries(entries []*OasDiffEntry, exemptionsFilePath string, fs afero.Fs) ([]*OasDiffEntry, error) {
-	exemptions, err := getExemptionsFromPath(exemptionsFilePath, fs)
-	if err != nil {
-		return nil, err
-	}
+	if exemptionsFilePath != "" {
+		exemptions, err := getExemptionsFromPath(exemptionsFilePath, fs)
+		if err != nil {
+			return nil, err
+		}
 
-	entries, err = hideByExemptions(entries, exemptions)
-	if err != nil {
-		return nil, err
+		entries, err = hideByExemptions(entries, exemptions)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return hideByIDs(entries, hideIDs)
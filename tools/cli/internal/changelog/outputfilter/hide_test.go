@@ -27,6 +27,18 @@ func TestMarkHiddenEntries(t *testing.T) {
 	assert.False(t, updatedEntries[1].HideFromChangelog)
 }
 
+func TestMarkHiddenEntries_NoExemptions(t *testing.T) {
+	entries := []*OasDiffEntry{
+		{ID: "response-required-property-became-write-only"},
+		{ID: "some-other-id"},
+	}
+
+	updatedEntries, err := MarkHiddenEntries(entries, "", afero.NewMemMapFs())
+	require.NoError(t, err)
+	assert.True(t, updatedEntries[0].HideFromChangelog)
+	assert.False(t, updatedEntries[1].HideFromChangelog)
+}
+
 func TestHideByIDs(t *testing.T) {
 	entries := []*OasDiffEntry{
 		{ID: "response-required-property-became-write-only"},
@@ -0,0 +1,115 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outputfilter
+
+import (
+	"cmp"
+	"embed"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed localizations/*.yaml
+var localizationFiles embed.FS
+
+var (
+	languageMu sync.RWMutex
+	language   = localizations.LangDefault
+	catalogs   = sync.OnceValue(func() map[string]*catalog {
+		result := make(map[string]*catalog)
+		for _, lang := range SupportedLanguages() {
+			data, err := localizationFiles.ReadFile(path.Join("localizations", lang+".yaml"))
+			if err != nil {
+				panic(fmt.Sprintf("missing message catalog for the language %s: %v", lang, err))
+			}
+
+			var c catalog
+			if err := yaml.Unmarshal(data, &c); err != nil {
+				panic(fmt.Sprintf("invalid message catalog for the language %s: %v", lang, err))
+			}
+			result[lang] = &c
+		}
+		return result
+	})
+)
+
+// catalog is the message catalog of a language, see localizations/en.yaml.
+type catalog struct {
+	Messages map[string]string            `yaml:"messages"`
+	Plurals  map[string]map[string]string `yaml:"plurals"`
+}
+
+// SupportedLanguages returns the languages of the changelog messages, the same as the oasdiff localizations.
+func SupportedLanguages() []string {
+	return localizations.GetSupportedLanguages()
+}
+
+// SetLanguage sets the language of the changelog messages. Empty restores the default language (en).
+func SetLanguage(lang string) error {
+	if lang == "" {
+		lang = localizations.LangDefault
+	}
+
+	if !slices.Contains(SupportedLanguages(), lang) {
+		return fmt.Errorf("unsupported language %q. Supported languages: %s", lang, strings.Join(SupportedLanguages(), ", "))
+	}
+
+	languageMu.Lock()
+	defer languageMu.Unlock()
+	language = lang
+	return nil
+}
+
+// Language returns the language of the changelog messages.
+func Language() string {
+	languageMu.RLock()
+	defer languageMu.RUnlock()
+	return language
+}
+
+// Localize formats the message of the change code generated by foascli in the language of the changelog messages.
+// The English message is used if the message is missing in the catalog of the language.
+func Localize(code string, args ...any) string {
+	format, ok := catalogs()[Language()].Messages[code]
+	if !ok {
+		format = catalogs()[localizations.LangDefault].Messages[code]
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// pluralize replaces the singular fragments of the message of the change code in the language with their plural form.
+// The longest fragments are replaced first, so that a fragment included in another one doesn't break it.
+func pluralize(lang, code, text string) string {
+	c, ok := catalogs()[lang]
+	if !ok {
+		return text
+	}
+
+	plurals := c.Plurals[code]
+	singulars := slices.SortedFunc(maps.Keys(plurals), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+	})
+	for _, singular := range singulars {
+		text = strings.ReplaceAll(text, singular, plurals[singular])
+	}
+	return text
+}
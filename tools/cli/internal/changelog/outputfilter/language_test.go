@@ -0,0 +1,110 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outputfilter
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLanguage(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, SetLanguage("")) })
+
+	require.NoError(t, SetLanguage("ru"))
+	assert.Equal(t, "ru", Language())
+
+	require.NoError(t, SetLanguage(""))
+	assert.Equal(t, "en", Language())
+
+	require.ErrorContains(t, SetLanguage("fr"), `unsupported language "fr". Supported languages: en, ru, pt-br`)
+	assert.Equal(t, "en", Language())
+}
+
+func TestLocalize(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, SetLanguage("")) })
+
+	testCases := []struct {
+		language string
+		expected string
+	}{
+		{
+			language: "en",
+			expected: "endpoint with API Version '2023-01-01' was removed as it has reached its sunset date '2025-01-01'",
+		},
+		{
+			language: "pt-br",
+			expected: "o endpoint com a versão da API '2023-01-01' foi removido pois atingiu sua data de desativação '2025-01-01'",
+		},
+		{
+			language: "ru",
+			expected: "эндпоинт с версией API '2023-01-01' удален, так как наступила дата его вывода из эксплуатации '2025-01-01'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.language, func(t *testing.T) {
+			require.NoError(t, SetLanguage(tc.language))
+			assert.Equal(t, tc.expected, Localize("endpoint-removed", "2023-01-01", "2025-01-01"))
+		})
+	}
+}
+
+func TestCatalogs(t *testing.T) {
+	english := catalogs()["en"].Messages
+	for _, lang := range SupportedLanguages() {
+		t.Run(lang, func(t *testing.T) {
+			c, ok := catalogs()[lang]
+			require.True(t, ok)
+			assert.Equal(t, slices.Sorted(maps.Keys(english)), slices.Sorted(maps.Keys(c.Messages)))
+			for code, format := range c.Messages {
+				assert.Equal(t, strings.Count(english[code], "%s"), strings.Count(format, "%s"), code)
+			}
+		})
+	}
+}
+
+func TestCatalogs_Plurals(t *testing.T) {
+	for _, lang := range SupportedLanguages() {
+		if lang == localizations.LangDefault {
+			continue
+		}
+
+		t.Run(lang, func(t *testing.T) {
+			messages := localizations.New(lang, localizations.LangDefault)
+			plurals := catalogs()[lang].Plurals
+			for _, handler := range newSquashHandlers() {
+				require.Contains(t, plurals, handler.id)
+				template := messages.Get("messages." + handler.id)
+				for singular := range plurals[handler.id] {
+					assert.Contains(t, template, singular, handler.id)
+				}
+			}
+		})
+	}
+}
+
+func TestPluralize(t *testing.T) {
+	assert.Equal(t,
+		"os novos valores 'A, B' do enum foram adicionados à propriedade de resposta 'state'",
+		pluralize("pt-br", "response-property-enum-value-added", "o novo valor 'A, B' do enum foi adicionado à propriedade de resposta 'state'"))
+	assert.Equal(t, "text", pluralize("en", "response-property-enum-value-added", "text"))
+	assert.Equal(t, "text", pluralize("fr", "response-property-enum-value-added", "text"))
+}
@@ -13,80 +13,14 @@
 // limitations under the License.
 package outputfilter
 
-import (
-	"regexp"
-)
+import "github.com/oasdiff/oasdiff/checker/localizations"
 
+// transformMessage rewrites the message of the entry with the message rules, see message_rules.yaml.
+// The localized message is rewritten with the rules of the language of the changelog messages.
 func transformMessage(entry *OasDiffEntry) *OasDiffEntry {
-	text := entry.Text
-	text = removeResponseStatusCodes(text)
-	text = setValueSet(text)
-	text = setValueRemoved(text)
-	text = removeInlineSchemaIndex(text)
-	text = removeRedundantOneOfAllOf(text)
-	entry.Text = text
-
+	entry.Text = messageRulesFor(localizations.LangDefault).Apply(entry.ID, entry.Text)
+	if entry.localizedText != "" {
+		entry.localizedText = messageRulesFor(Language()).Apply(entry.ID, entry.localizedText)
+	}
 	return entry
 }
-
-func removeRedundantOneOfAllOf(text string) string {
-	// /oneOf/components/schemas/<ViewName>/
-	re := regexp.MustCompile(`/oneOf/components/schemas/[^/]+/`)
-	text = re.ReplaceAllString(text, "")
-
-	re = regexp.MustCompile(`/allOf/components/schemas/[^/]+/`)
-	text = re.ReplaceAllString(text, "")
-
-	re = regexp.MustCompile(`oneOf\[#/components/schemas/[^/]+\]/`)
-	text = re.ReplaceAllString(text, "")
-
-	re = regexp.MustCompile(`allOf\[#/components/schemas/[^/]+\]/`)
-	text = re.ReplaceAllString(text, "")
-
-	return text
-}
-
-// removeResponseStatusCodes removes the status codes from the response messages.
-func removeResponseStatusCodes(text string) string {
-	// to the response with the '200' status
-	re := regexp.MustCompile(` property for the response status '\d{3}'`)
-	text = re.ReplaceAllString(text, " property")
-
-	re = regexp.MustCompile(` to the response with the '\d{3}' status`)
-	text = re.ReplaceAllString(text, " to the response")
-
-	re = regexp.MustCompile(` from the response with the '\d{3}' status`)
-	text = re.ReplaceAllString(text, " from the response")
-
-	re = regexp.MustCompile(` list for the response status '\d{3}'`)
-	text = re.ReplaceAllString(text, " list for the response")
-
-	re = regexp.MustCompile(` for the status '\d{3}'`)
-	text = re.ReplaceAllString(text, "")
-
-	return text
-}
-
-func setValueSet(text string) string {
-	re := regexp.MustCompile(`default value( was)? changed from 'null' to ('.+')`)
-	text = re.ReplaceAllString(text, "default value was set to $2")
-
-	return text
-}
-
-func setValueRemoved(text string) string {
-	re := regexp.MustCompile(`default value( was)? changed from '.+' to 'null'`)
-	text = re.ReplaceAllString(text, "default value was removed")
-
-	return text
-}
-
-func removeInlineSchemaIndex(text string) string {
-	re := regexp.MustCompile(`BaseSchema\[\d+]:`)
-	text = re.ReplaceAllString(text, "")
-
-	re = regexp.MustCompile(`RevisionSchema\[\d+]:`)
-	text = re.ReplaceAllString(text, "")
-
-	return text
-}
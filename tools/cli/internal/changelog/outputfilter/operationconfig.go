@@ -9,6 +9,7 @@ type OperationConfig struct {
 	Path                   string
 	HTTPMethod             string
 	Tag                    string
+	OwnerTeam              string
 	Sunset                 string
 	ManualChangelogEntries map[string]any
 }
@@ -30,6 +31,19 @@ func (e *OperationConfigs) Tag() string {
 	return ""
 }
 
+// OwnerTeam returns the x-xgen-owner-team of the operation, preferring the revision spec.
+func (e *OperationConfigs) OwnerTeam() string {
+	if e.Revision != nil && e.Revision.OwnerTeam != "" {
+		return e.Revision.OwnerTeam
+	}
+
+	if e.Base != nil {
+		return e.Base.OwnerTeam
+	}
+
+	return ""
+}
+
 func (e *OperationConfigs) Sunset() string {
 	if e.Revision != nil && e.Revision.Sunset != "" {
 		return e.Revision.Sunset
@@ -109,6 +123,11 @@ func newEndpointConfig(pathName, operatioName string, operation *openapi3.Operat
 		sunset = value.(string)
 	}
 
+	ownerTeam := ""
+	if value, ok := operation.Extensions["x-xgen-owner-team"].(string); ok {
+		ownerTeam = value
+	}
+
 	manualChangelogEntries := make(map[string]any)
 	if value, ok := operation.Extensions["x-xgen-changelog"]; ok {
 		manualChangelogEntries = value.(map[string]any)
@@ -116,6 +135,7 @@ func newEndpointConfig(pathName, operatioName string, operation *openapi3.Operat
 
 	return &OperationConfig{
 		Tag:                    tag,
+		OwnerTeam:              ownerTeam,
 		Path:                   pathName,
 		HTTPMethod:             operatioName,
 		Sunset:                 sunset,
@@ -100,6 +100,47 @@ func TestOperationConfigs_Tag(t *testing.T) {
 	}
 }
 
+func TestOperationConfigs_OwnerTeam(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  OperationConfigs
+		expected string
+	}{
+		{
+			name: "OwnerTeam from Revision",
+			configs: OperationConfigs{
+				Base:     &OperationConfig{OwnerTeam: "Base Team"},
+				Revision: &OperationConfig{OwnerTeam: "Revision Team"},
+			},
+			expected: "Revision Team",
+		},
+		{
+			name: "OwnerTeam from Base when the Revision has none",
+			configs: OperationConfigs{
+				Base:     &OperationConfig{OwnerTeam: "Base Team"},
+				Revision: &OperationConfig{},
+			},
+			expected: "Base Team",
+		},
+		{
+			name: "No OwnerTeam",
+			configs: OperationConfigs{
+				Base:     nil,
+				Revision: nil,
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.configs.OwnerTeam(); got != tt.expected {
+				t.Errorf("OwnerTeam() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestOperationConfigs_Sunset(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -448,6 +489,57 @@ func TestNewEndpointsConfigGivenBaseAndRevision(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Base and revision with x-xgen-owner-team extension",
+			baseSpec: &load.SpecInfo{
+				Spec: &openapi3.T{
+					Paths: openapi3.NewPaths(
+						openapi3.WithPath("/path", &openapi3.PathItem{
+							Get: &openapi3.Operation{
+								OperationID: "op1",
+								Tags:        []string{"base-tag"},
+								Extensions: map[string]any{
+									"x-xgen-owner-team": "Base Team",
+								},
+							},
+						}),
+					),
+				},
+			},
+			revisionSpec: &load.SpecInfo{
+				Spec: &openapi3.T{
+					Paths: openapi3.NewPaths(
+						openapi3.WithPath("/path", &openapi3.PathItem{
+							Get: &openapi3.Operation{
+								OperationID: "op1",
+								Tags:        []string{"revision-tag"},
+								Extensions: map[string]any{
+									"x-xgen-owner-team": "Revision Team",
+								},
+							},
+						}),
+					),
+				},
+			},
+			expected: map[string]*OperationConfigs{
+				"op1": {
+					Base: &OperationConfig{
+						Path:                   "/path",
+						HTTPMethod:             "GET",
+						Tag:                    "base-tag",
+						OwnerTeam:              "Base Team",
+						ManualChangelogEntries: map[string]any{},
+					},
+					Revision: &OperationConfig{
+						Path:                   "/path",
+						HTTPMethod:             "GET",
+						Tag:                    "revision-tag",
+						OwnerTeam:              "Revision Team",
+						ManualChangelogEntries: map[string]any{},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
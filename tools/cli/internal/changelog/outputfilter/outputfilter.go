@@ -17,13 +17,12 @@ import (
 	"encoding/json"
 
 	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/checker/localizations"
 	"github.com/oasdiff/oasdiff/formatters"
 	"github.com/oasdiff/oasdiff/load"
 	"github.com/spf13/afero"
 )
 
-const lan = "en" // language for localized output
-
 type OasDiffEntry struct {
 	ID                string `json:"id"`
 	Date              string `json:"date"`
@@ -35,6 +34,9 @@ type OasDiffEntry struct {
 	Source            string `json:"source,omitempty"`
 	Section           string `json:"section"`
 	HideFromChangelog bool   `json:"hideFromChangelog,omitempty"`
+	// localizedText is the message in the language of the changelog messages, replacing Text once the entries are
+	// filtered and squashed with the English messages. It is empty for English.
+	localizedText string
 }
 
 func (o *OasDiffEntry) LevelWithDefault() int {
@@ -44,9 +46,45 @@ func (o *OasDiffEntry) LevelWithDefault() int {
 	return int(checker.INFO)
 }
 
+// NewChangelogEntries returns the changelog entries of the oasdiff changes in the language of the changelog messages.
+// The entries are hidden, rewritten and squashed with the English messages, which the exemptions and the squash
+// handlers rely on, and their messages are replaced with the localized messages at the end.
 func NewChangelogEntries(checkers checker.Changes, specInfoPair *load.SpecInfoPair, exemptionsFilePath string) ([]*OasDiffEntry, error) {
+	entries, err := renderEntries(checkers, specInfoPair, localizations.LangDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	if lang := Language(); lang != localizations.LangDefault {
+		localizedEntries, err := renderEntries(checkers, specInfoPair, lang)
+		if err != nil {
+			return nil, err
+		}
+
+		// oasdiff renders the changes in the same order in every language
+		for i, entry := range entries {
+			entry.localizedText = localizedEntries[i].Text
+		}
+	}
+
+	entries, err = transformEntries(entries, exemptionsFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.localizedText != "" {
+			entry.Text = entry.localizedText
+		}
+	}
+
+	return entries, nil
+}
+
+// renderEntries renders the oasdiff changes with their messages in the language.
+func renderEntries(checkers checker.Changes, specInfoPair *load.SpecInfoPair, lang string) ([]*OasDiffEntry, error) {
 	formatter, err := formatters.Lookup("json", formatters.FormatterOpts{
-		Language: lan,
+		Language: lang,
 	})
 	if err != nil {
 		return nil, err
@@ -59,12 +97,11 @@ func NewChangelogEntries(checkers checker.Changes, specInfoPair *load.SpecInfoPa
 	}
 
 	var entries []*OasDiffEntry
-	err = json.Unmarshal(bytes, &entries)
-	if err != nil {
+	if err := json.Unmarshal(bytes, &entries); err != nil {
 		return nil, err
 	}
 
-	return transformEntries(entries, exemptionsFilePath)
+	return entries, nil
 }
 
 func transformEntries(entries []*OasDiffEntry, exemptionsFilePath string) ([]*OasDiffEntry, error) {
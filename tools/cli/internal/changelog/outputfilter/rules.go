@@ -0,0 +1,168 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package outputfilter
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed message_rules*.yaml
+var messageRuleFiles embed.FS
+
+var (
+	messageRulesMu sync.RWMutex
+	messageRules   = sync.OnceValue(func() map[string]*MessageRules {
+		result := make(map[string]*MessageRules)
+		for _, lang := range SupportedLanguages() {
+			data, err := messageRuleFiles.ReadFile(messageRulesFileName(lang))
+			if err != nil {
+				panic(fmt.Sprintf("missing default message rules for the language %s: %v", lang, err))
+			}
+
+			rules, err := NewMessageRules(data)
+			if err != nil {
+				panic(fmt.Sprintf("invalid default message rules for the language %s: %v", lang, err))
+			}
+			result[lang] = rules
+		}
+		return result
+	})
+	customMessageRules *MessageRules
+)
+
+// messageRulesFileName returns the name of the default rules file of the language, e.g. message_rules.ru.yaml.
+func messageRulesFileName(lang string) string {
+	if lang == localizations.LangDefault {
+		return "message_rules.yaml"
+	}
+	return "message_rules." + lang + ".yaml"
+}
+
+// MessageRule rewrites the matches of the pattern in the changelog messages.
+type MessageRule struct {
+	Name        string   `yaml:"name"`
+	Pattern     string   `yaml:"pattern"`
+	Replacement string   `yaml:"replacement"`
+	ChangeCodes []string `yaml:"change_codes,omitempty"`
+	re          *regexp.Regexp
+}
+
+// MessageRules are the rules applied in order to the changelog messages.
+type MessageRules struct {
+	Rules []*MessageRule `yaml:"rules"`
+}
+
+// NewMessageRules parses and compiles the rules of the YAML rules file contents.
+func NewMessageRules(data []byte) (*MessageRules, error) {
+	var rules MessageRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse the message rules: %w", err)
+	}
+
+	for i, rule := range rules.Rules {
+		if rule.Pattern == "" {
+			return nil, fmt.Errorf("the message rule %d (%s) has no pattern", i, rule.Name)
+		}
+
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("the message rule %d (%s) has an invalid pattern: %w", i, rule.Name, err)
+		}
+		rule.re = re
+	}
+
+	return &rules, nil
+}
+
+// NewMessageRulesFromPath reads the YAML rules file at the path.
+func NewMessageRulesFromPath(path string, fs afero.Fs) (*MessageRules, error) {
+	if path == "" {
+		return nil, errors.New("could not find the message rules file path")
+	}
+
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the message rules file: %w", err)
+	}
+
+	return NewMessageRules(data)
+}
+
+// DefaultMessageRules returns the rules shipped with foascli for the English messages, compiled once.
+func DefaultMessageRules() *MessageRules {
+	return DefaultMessageRulesForLanguage(localizations.LangDefault)
+}
+
+// DefaultMessageRulesForLanguage returns the rules shipped with foascli for the messages in the language,
+// or nil if the language is not supported.
+func DefaultMessageRulesForLanguage(lang string) *MessageRules {
+	return messageRules()[lang]
+}
+
+// SetMessageRules replaces the rules applied to the changelog messages in the language set with SetLanguage.
+// Nil restores the default rules.
+func SetMessageRules(rules *MessageRules) {
+	messageRulesMu.Lock()
+	defer messageRulesMu.Unlock()
+	customMessageRules = rules
+}
+
+// messageRulesFor returns the rules applied to the messages in the language: the custom rules for the language
+// of the changelog messages if set, otherwise the default rules of the language.
+func messageRulesFor(lang string) *MessageRules {
+	messageRulesMu.RLock()
+	defer messageRulesMu.RUnlock()
+	if customMessageRules != nil && lang == Language() {
+		return customMessageRules
+	}
+	return DefaultMessageRulesForLanguage(lang)
+}
+
+// Apply rewrites the message of a change with the change code using the rules in order.
+func (r *MessageRules) Apply(code, text string) string {
+	for _, rule := range r.Rules {
+		if rule.matchCode(code) {
+			text = rule.re.ReplaceAllString(text, rule.Replacement)
+		}
+	}
+	return text
+}
+
+// Trace returns the names of the rules changing the message of a change with the change code, and the rewritten message.
+func (r *MessageRules) Trace(code, text string) (applied []string, result string) {
+	for _, rule := range r.Rules {
+		if !rule.matchCode(code) {
+			continue
+		}
+
+		if newText := rule.re.ReplaceAllString(text, rule.Replacement); newText != text {
+			applied = append(applied, rule.Name)
+			text = newText
+		}
+	}
+	return applied, text
+}
+
+func (r *MessageRule) matchCode(code string) bool {
+	return len(r.ChangeCodes) == 0 || slices.Contains(r.ChangeCodes, code)
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package outputfilter
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultMessageRules(t *testing.T) {
+	rules := DefaultMessageRules()
+	require.Len(t, rules.Rules, 13)
+	assert.Same(t, rules, DefaultMessageRules())
+
+	for _, rule := range rules.Rules {
+		assert.NotEmpty(t, rule.Name)
+		assert.NotNil(t, rule.re, rule.Name)
+	}
+}
+
+func TestNewMessageRules(t *testing.T) {
+	rules, err := NewMessageRules([]byte(`
+rules:
+  - name: rename-endpoint
+    pattern: 'endpoint added'
+    replacement: 'new endpoint'
+    change_codes: [endpoint-added]
+  - name: remove-quotes
+    pattern: '''(\w+)'''
+    replacement: '$1'
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "new endpoint", rules.Apply("endpoint-added", "endpoint added"))
+	assert.Equal(t, "endpoint added", rules.Apply("endpoint-removed", "endpoint added"))
+	assert.Equal(t, "removed the property name", rules.Apply("response-optional-property-removed", "removed the property 'name'"))
+
+	applied, text := rules.Trace("endpoint-added", "endpoint added for 'name'")
+	assert.Equal(t, []string{"rename-endpoint", "remove-quotes"}, applied)
+	assert.Equal(t, "new endpoint for name", text)
+
+	applied, text = rules.Trace("endpoint-removed", "endpoint removed")
+	assert.Empty(t, applied)
+	assert.Equal(t, "endpoint removed", text)
+}
+
+func TestNewMessageRules_Invalid(t *testing.T) {
+	_, err := NewMessageRules([]byte("rules:\n  - name: invalid\n    pattern: '('\n"))
+	require.ErrorContains(t, err, "the message rule 0 (invalid) has an invalid pattern")
+
+	_, err = NewMessageRules([]byte("rules:\n  - name: empty\n"))
+	require.EqualError(t, err, "the message rule 0 (empty) has no pattern")
+
+	_, err = NewMessageRules([]byte("rules: {"))
+	require.ErrorContains(t, err, "failed to parse the message rules")
+}
+
+func TestNewMessageRulesFromPath(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	data, err := messageRuleFiles.ReadFile("message_rules.yaml")
+	require.NoError(t, err)
+	require.NoError(t, afero.WriteFile(fs, "rules.yaml", data, 0o600))
+
+	rules, err := NewMessageRulesFromPath("rules.yaml", fs)
+	require.NoError(t, err)
+	assert.Len(t, rules.Rules, len(DefaultMessageRules().Rules))
+
+	_, err = NewMessageRulesFromPath("", fs)
+	require.EqualError(t, err, "could not find the message rules file path")
+
+	_, err = NewMessageRulesFromPath("missing.yaml", fs)
+	require.ErrorContains(t, err, "could not read the message rules file")
+}
+
+func TestSetMessageRules(t *testing.T) {
+	rules, err := NewMessageRules([]byte("rules:\n  - name: upper\n    pattern: 'endpoint'\n    replacement: 'Endpoint'\n"))
+	require.NoError(t, err)
+
+	SetMessageRules(rules)
+	t.Cleanup(func() { SetMessageRules(nil) })
+	assert.Equal(t, "Endpoint added", transformMessage(&OasDiffEntry{ID: "endpoint-added", Text: "endpoint added"}).Text)
+
+	SetMessageRules(nil)
+	assert.Equal(t, "endpoint added", transformMessage(&OasDiffEntry{ID: "endpoint-added", Text: "endpoint added"}).Text)
+}
+
+func TestSetMessageRules_Language(t *testing.T) {
+	rules, err := NewMessageRules([]byte(`rules:
+  - name: remove-status
+    pattern: ' со статусом ''\d{3}'''
+    replacement: ''
+`))
+	require.NoError(t, err)
+
+	require.NoError(t, SetLanguage("ru"))
+	SetMessageRules(rules)
+	t.Cleanup(func() {
+		SetMessageRules(nil)
+		require.NoError(t, SetLanguage(""))
+	})
+
+	// The custom rules rewrite the messages in the language, the English messages keep the default rules
+	entry := transformMessage(&OasDiffEntry{
+		ID:            "response-optional-property-added",
+		Text:          "added the optional property 'name' to the response with the '200' status",
+		localizedText: "добавлено необязательное свойство 'name' в ответе со статусом '200'",
+	})
+	assert.Equal(t, "added the optional property 'name' to the response", entry.Text)
+	assert.Equal(t, "добавлено необязательное свойство 'name' в ответе", entry.localizedText)
+}
+
+func TestDefaultMessageRulesForLanguage(t *testing.T) {
+	for _, lang := range SupportedLanguages() {
+		assert.NotEmpty(t, DefaultMessageRulesForLanguage(lang).Rules, lang)
+	}
+	assert.Nil(t, DefaultMessageRulesForLanguage("fr"))
+}
@@ -235,28 +235,11 @@ func squashEntriesByValues(
 		}
 
 		templateEntry := opEntries[0]
-		templateText := templateEntry.Text
 
 		for _, squashData := range squashMap {
 			keyValues := squashData.valuesNotSquashed
 			squashValues := squashData.valuesToSquash
 
-			text := templateText
-			for idx, value := range keyValues {
-				var valuesToAddToTemplate string
-				if idx == squashIdx {
-					squashList := []string{}
-					for sv := range squashValues {
-						squashList = append(squashList, sv)
-					}
-					sort.Strings(squashList)
-					valuesToAddToTemplate = strings.Join(squashList, ", ")
-				} else {
-					valuesToAddToTemplate = value
-				}
-				text = replaceOnlyFirstOccurrence(text, valuesToAddToTemplate)
-			}
-
 			squashedEntry := &OasDiffEntry{
 				ID:                templateEntry.ID,
 				OperationID:       templateEntry.OperationID,
@@ -270,20 +253,46 @@ func squashEntriesByValues(
 			}
 
 			squashedEntry.Text = strings.ReplaceAll(
-				strings.ReplaceAll(
-					text,
-					"``",
-					"'",
-				),
+				fillTemplate(templateEntry.Text, keyValues, squashIdx, squashValues),
 				pluralizeFrom,
 				pluralizeToIfNeeded(pluralizeFrom, pluralizeTo, len(squashValues)),
 			)
+
+			// The localized message has the values in the same order as the English message
+			if templateEntry.localizedText != "" {
+				squashedEntry.localizedText = fillTemplate(templateEntry.localizedText, keyValues, squashIdx, squashValues)
+				if len(squashValues) > 1 {
+					squashedEntry.localizedText = pluralize(Language(), templateEntry.ID, squashedEntry.localizedText)
+				}
+			}
 			result = append(result, squashedEntry)
 		}
 	}
 	return result, nil
 }
 
+// fillTemplate replaces the values enclosed in single quotes of the template with the key values in order,
+// and the value at squashIdx with the sorted list of squashed values.
+func fillTemplate(template string, keyValues []string, squashIdx int, squashValues map[string]struct{}) string {
+	text := template
+	for idx, value := range keyValues {
+		var valuesToAddToTemplate string
+		if idx == squashIdx {
+			squashList := []string{}
+			for sv := range squashValues {
+				squashList = append(squashList, sv)
+			}
+			sort.Strings(squashList)
+			valuesToAddToTemplate = strings.Join(squashList, ", ")
+		} else {
+			valuesToAddToTemplate = value
+		}
+		text = replaceOnlyFirstOccurrence(text, valuesToAddToTemplate)
+	}
+
+	return strings.ReplaceAll(text, "``", "'")
+}
+
 // replaceOnlyFirstOccurrence replaces only the first occurrence of the identifierRegex
 // in the template with the valuesToAddToTemplate.
 // Why do we need to replace only the first occurrence?
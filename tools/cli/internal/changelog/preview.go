@@ -0,0 +1,200 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+)
+
+const (
+	PreviewFolderName        = "preview"
+	PrivatePreviewFolderName = "private-preview"
+	privatePreviewPrefix     = apiversion.PrivatePreviewStabilityLevel + "-"
+	changelogFileName        = "changelog"
+)
+
+// PreviewEntries is the changelog of a preview or upcoming version.
+type PreviewEntries struct {
+	// Version is the version of the spec, e.g. preview, private-preview-<name> or 2025-09-22.upcoming.
+	Version string
+	// Name is the x-xgen-preview name of private previews, otherwise the version.
+	Name    string
+	Entries []*Entry
+}
+
+// Path returns the path of the changelog file of the preview or upcoming version, relative to the changelog folder.
+// Private previews are keyed by their x-xgen-preview name in their own folder, so that they can't clash with
+// the public preview.
+func (p *PreviewEntries) Path() string {
+	return PreviewChangelogPath(p.Version)
+}
+
+// PreviewChangelogPath returns the path of the changelog file of the preview or upcoming version,
+// relative to the changelog folder.
+func PreviewChangelogPath(version string) string {
+	if apiversion.IsPrivatePreviewStabilityLevel(version) {
+		return filepath.Join(PrivatePreviewFolderName, fmt.Sprintf("%s-%s.json", changelogFileName, previewName(version)))
+	}
+	return filepath.Join(PreviewFolderName, fmt.Sprintf("%s-%s.json", changelogFileName, version))
+}
+
+// NewPreviewEntriesWithRunDate generates the changelogs of the preview and upcoming versions, which are skipped by
+// NewEntriesWithRunDate. The versions are the openapi-<version>.json files of the revision folder, since the metadata
+// only lists the stable versions. The previous changelog of each version is read from the base folder at
+// PreviewChangelogPath, and starts empty otherwise. A version without spec in the base folder has no changes until
+// the next run, since there is nothing to compare it against. It fails if the revision folder has preview or upcoming
+// versions but the base folder has none.
+// The returned entries include the hidden changes and are sorted by version.
+func NewPreviewEntriesWithRunDate(basePath, revisionPath, exceptionFilePath, runDate string) ([]*PreviewEntries, error) {
+	baseMetadata, err := newMetadataFromFile(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := newPreviewVersionsFromPath(revisionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVersions, err := newPreviewVersionsFromPath(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without any preview or upcoming spec in the base folder, every changelog would be written empty
+	if len(versions) > 0 && len(baseVersions) == 0 {
+		return nil, fmt.Errorf(
+			"the base folder %s has no preview or upcoming specs. Copy the openapi-<version>.json files of the preview and upcoming versions, "+
+				"and their previous changelogs in the %s and %s folders, to the base folder",
+			basePath, PreviewFolderName, PrivatePreviewFolderName)
+	}
+
+	previews := make([]*PreviewEntries, 0, len(versions))
+	for _, version := range versions {
+		entries, err := newPreviewEntries(baseMetadata, revisionPath, version, exceptionFilePath, runDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the changelog of the version %s: %w", version, err)
+		}
+
+		previews = append(previews, &PreviewEntries{
+			Version: version,
+			Name:    previewName(version),
+			Entries: entries,
+		})
+	}
+
+	return previews, nil
+}
+
+func newPreviewEntries(baseMetadata *Metadata, revisionPath, version, exceptionFilePath, runDate string) ([]*Entry, error) {
+	baseChangelog, err := NewEntriesFromPath(filepath.Join(baseMetadata.Path, PreviewChangelogPath(version)))
+	if errors.Is(err, os.ErrNotExist) {
+		baseChangelog, err = []*Entry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(filepath.Join(baseMetadata.Path, fmt.Sprintf("openapi-%s.json", version))); errors.Is(err, os.ErrNotExist) {
+		log.Printf("The version %s is not in the base specs, its changelog starts at the next run.", version)
+		return baseChangelog, nil
+	}
+
+	changelog, err := newChangelog(
+		&Metadata{
+			Path:          baseMetadata.Path,
+			ActiveVersion: version,
+			RunDate:       baseMetadata.RunDate,
+			Versions:      []string{version},
+		},
+		&Metadata{
+			Path:          revisionPath,
+			ActiveVersion: version,
+			RunDate:       runDate,
+			Versions:      []string{version},
+		},
+		exceptionFilePath,
+		baseChangelog)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := changelog.newEntryFromOasDiff()
+	if err != nil {
+		return nil, err
+	}
+
+	setStabilityLevel(entries, version)
+	return entries, nil
+}
+
+// newPreviewVersionsFromPath returns the preview and upcoming versions of the openapi-<version>.json files in the folder.
+func newPreviewVersionsFromPath(path string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(path, "openapi-*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0)
+	for _, file := range files {
+		version := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), "openapi-"), ".json")
+		if apiversion.IsPreviewStabilityLevel(version) || apiversion.IsUpcomingStabilityLevel(version) {
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// setStabilityLevel replaces the stable stability level, set to all the changes by the changelog merge,
+// with the stability level of the version.
+func setStabilityLevel(entries []*Entry, version string) {
+	stabilityLevel := apiversion.StableStabilityLevel
+	switch {
+	case apiversion.IsPrivatePreviewStabilityLevel(version):
+		stabilityLevel = apiversion.PrivatePreviewStabilityLevel
+	case apiversion.IsPreviewStabilityLevel(version):
+		stabilityLevel = apiversion.PreviewStabilityLevel
+	case apiversion.IsUpcomingStabilityLevel(version):
+		stabilityLevel = apiversion.UpcomingStabilityLevel
+	}
+
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			for _, v := range path.Versions {
+				if v.Version == version {
+					v.StabilityLevel = stabilityLevel
+				}
+			}
+		}
+	}
+}
+
+// previewName returns the x-xgen-preview name of a private preview version, otherwise the version.
+func previewName(version string) string {
+	if apiversion.IsPrivatePreviewStabilityLevel(version) {
+		return strings.TrimPrefix(version, privatePreviewPrefix)
+	}
+	return version
+}
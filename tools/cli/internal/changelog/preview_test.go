@@ -0,0 +1,115 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPreviewTestSpec(operationIDs ...string) string {
+	paths := make([]string, 0, len(operationIDs))
+	for _, operationID := range operationIDs {
+		paths = append(paths, fmt.Sprintf(`"/api/atlas/v2/%s": {"get": {"operationId": %q, "tags": ["Things"], "responses": {"200": {
+			"description": "OK", "content": {"application/vnd.atlas.preview+json": {"schema": {"type": "string"}}}}}}}`, operationID, operationID))
+	}
+	return fmt.Sprintf(`{"openapi": "3.0.1", "info": {"title": "Test", "version": "2.0"}, "paths": {%s}}`, strings.Join(paths, ","))
+}
+
+func writePreviewTestFile(t *testing.T, path, contents string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+}
+
+func TestNewPreviewEntriesWithRunDate(t *testing.T) {
+	basePath := t.TempDir()
+	revisionPath := t.TempDir()
+	exemptionsPath := filepath.Join(basePath, "exemptions.yaml")
+	writePreviewTestFile(t, exemptionsPath, "")
+	writePreviewTestFile(t, filepath.Join(basePath, "metadata.json"), `{"runDate": "2025-06-11", "versions": ["2025-03-12"]}`)
+
+	writePreviewTestFile(t, filepath.Join(basePath, "openapi-2025-03-12.json"), newPreviewTestSpec("getThings"))
+	writePreviewTestFile(t, filepath.Join(revisionPath, "openapi-2025-03-12.json"), newPreviewTestSpec("getThings", "getStableThings"))
+
+	writePreviewTestFile(t, filepath.Join(basePath, "openapi-preview.json"), newPreviewTestSpec("getThings"))
+	writePreviewTestFile(t, filepath.Join(revisionPath, "openapi-preview.json"), newPreviewTestSpec("getThings", "getPreviewThings"))
+	baseChangelog, err := json.Marshal([]*Entry{{Date: "2025-06-01", Paths: []*Path{{URI: "/api/atlas/v2/getThings", HTTPMethod: "GET"}}}})
+	require.NoError(t, err)
+	writePreviewTestFile(t, filepath.Join(basePath, "preview", "changelog-preview.json"), string(baseChangelog))
+
+	writePreviewTestFile(t, filepath.Join(basePath, "openapi-private-preview-sandbox.json"), newPreviewTestSpec("getThings"))
+	writePreviewTestFile(t, filepath.Join(revisionPath, "openapi-private-preview-sandbox.json"), newPreviewTestSpec())
+
+	writePreviewTestFile(t, filepath.Join(revisionPath, "openapi-2025-09-22.upcoming.json"), newPreviewTestSpec("getThings"))
+
+	previews, err := NewPreviewEntriesWithRunDate(basePath, revisionPath, exemptionsPath, "2025-06-12")
+	require.NoError(t, err)
+	require.Len(t, previews, 3)
+
+	upcoming := previews[0]
+	assert.Equal(t, "2025-09-22.upcoming", upcoming.Version)
+	assert.Equal(t, "preview/changelog-2025-09-22.upcoming.json", upcoming.Path())
+	assert.Empty(t, upcoming.Entries)
+
+	preview := previews[1]
+	assert.Equal(t, "preview", preview.Version)
+	assert.Equal(t, "preview", preview.Name)
+	assert.Equal(t, "preview/changelog-preview.json", preview.Path())
+	require.Len(t, preview.Entries, 2)
+	assert.Equal(t, "2025-06-12", preview.Entries[0].Date)
+	require.Len(t, preview.Entries[0].Paths, 1)
+	assert.Equal(t, "getPreviewThings", preview.Entries[0].Paths[0].OperationID)
+	require.Len(t, preview.Entries[0].Paths[0].Versions, 1)
+	assert.Equal(t, "preview", preview.Entries[0].Paths[0].Versions[0].Version)
+	assert.Equal(t, "preview", preview.Entries[0].Paths[0].Versions[0].StabilityLevel)
+	assert.Equal(t, "endpoint-added", preview.Entries[0].Paths[0].Versions[0].Changes[0].Code)
+	assert.Equal(t, "2025-06-01", preview.Entries[1].Date)
+
+	privatePreview := previews[2]
+	assert.Equal(t, "private-preview-sandbox", privatePreview.Version)
+	assert.Equal(t, "sandbox", privatePreview.Name)
+	assert.Equal(t, "private-preview/changelog-sandbox.json", privatePreview.Path())
+	require.Len(t, privatePreview.Entries, 1)
+	require.Len(t, privatePreview.Entries[0].Paths, 1)
+	assert.Equal(t, "getThings", privatePreview.Entries[0].Paths[0].OperationID)
+	assert.Equal(t, "private-preview", privatePreview.Entries[0].Paths[0].Versions[0].StabilityLevel)
+	assert.Equal(t, "api-path-removed-without-deprecation", privatePreview.Entries[0].Paths[0].Versions[0].Changes[0].Code)
+}
+
+func TestNewPreviewEntriesWithRunDate_NoBasePreviews(t *testing.T) {
+	basePath := t.TempDir()
+	revisionPath := t.TempDir()
+	writePreviewTestFile(t, filepath.Join(basePath, "metadata.json"), `{"runDate": "2025-06-11", "versions": ["2025-03-12"]}`)
+	writePreviewTestFile(t, filepath.Join(basePath, "openapi-2025-03-12.json"), newPreviewTestSpec("getThings"))
+	writePreviewTestFile(t, filepath.Join(revisionPath, "openapi-preview.json"), newPreviewTestSpec("getThings"))
+
+	_, err := NewPreviewEntriesWithRunDate(basePath, revisionPath, "", "2025-06-12")
+	require.ErrorContains(t, err, "has no preview or upcoming specs")
+}
+
+func TestNewPreviewEntriesWithRunDate_NoPreviews(t *testing.T) {
+	testPath := "../../test/data/changelog/new-api-version"
+	previews, err := NewPreviewEntriesWithRunDate(testPath+"/base", testPath+"/revision", testPath+"/exemptions.yaml", "2024-08-08")
+	require.NoError(t, err)
+	assert.Empty(t, previews)
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"slices"
+	"strings"
+)
+
+// Query selects the changes of the changelog entries. Empty fields match all the changes.
+type Query struct {
+	// From and To are the inclusive date range of the entries. (Format: YYYY-MM-DD)
+	From               string
+	To                 string
+	OperationIDs       []string
+	Tags               []string
+	PathPrefix         string
+	Versions           []string
+	ChangeCodes        []string
+	BackwardCompatible *bool
+	Hidden             *bool
+}
+
+// Apply returns the entries with only the changes matching the query.
+// Versions, paths and entries without matching changes are removed. The input entries are not modified.
+func (q *Query) Apply(entries []*Entry) []*Entry {
+	out := make([]*Entry, 0)
+	for _, entry := range entries {
+		if !q.matchDate(entry.Date) {
+			continue
+		}
+
+		paths := make([]*Path, 0)
+		for _, path := range entry.Paths {
+			if p := q.applyPath(path, entry.ToVersion); p != nil {
+				paths = append(paths, p)
+			}
+		}
+
+		if len(paths) > 0 {
+			out = append(out, &Entry{
+				Date:        entry.Date,
+				Paths:       paths,
+				FromVersion: entry.FromVersion,
+				ToVersion:   entry.ToVersion,
+			})
+		}
+	}
+
+	return out
+}
+
+// applyPath returns a copy of the path with the matching changes or nil if no change matches.
+// The changes of a version diff entry are stored in the path and belong to the entry ToVersion.
+func (q *Query) applyPath(path *Path, toVersion string) *Path {
+	if !q.matchPath(path) {
+		return nil
+	}
+
+	versions := make([]*Version, 0)
+	for _, version := range path.Versions {
+		if !matchAny(q.Versions, version.Version) {
+			continue
+		}
+
+		if changes := q.applyChanges(version.Changes); len(changes) > 0 {
+			versions = append(versions, &Version{
+				Version:        version.Version,
+				Changes:        changes,
+				StabilityLevel: version.StabilityLevel,
+				ChangeType:     version.ChangeType,
+			})
+		}
+	}
+
+	var changes []*Change
+	if len(path.Changes) > 0 && matchAny(q.Versions, toVersion) {
+		changes = q.applyChanges(path.Changes)
+	}
+
+	if len(versions) == 0 && len(changes) == 0 {
+		return nil
+	}
+
+	out := *path
+	out.Versions = nil
+	if len(versions) > 0 {
+		out.Versions = versions
+	}
+	out.Changes = changes
+	return &out
+}
+
+func (q *Query) applyChanges(changes []*Change) []*Change {
+	out := make([]*Change, 0)
+	for _, change := range changes {
+		if q.matchChange(change) {
+			out = append(out, change)
+		}
+	}
+	return out
+}
+
+func (q *Query) matchDate(date string) bool {
+	// The dates are in the YYYY-MM-DD format, so they can be compared as strings
+	if q.From != "" && date < q.From {
+		return false
+	}
+	return q.To == "" || date <= q.To
+}
+
+func (q *Query) matchPath(path *Path) bool {
+	return matchAny(q.OperationIDs, path.OperationID) &&
+		matchAny(q.Tags, path.Tag) &&
+		strings.HasPrefix(path.URI, q.PathPrefix)
+}
+
+func (q *Query) matchChange(change *Change) bool {
+	if !matchAny(q.ChangeCodes, change.Code) {
+		return false
+	}
+
+	if q.BackwardCompatible != nil && *q.BackwardCompatible != change.BackwardCompatible {
+		return false
+	}
+
+	return q.Hidden == nil || *q.Hidden == change.HideFromChangelog
+}
+
+// matchAny reports whether the value is in the values, or true if there are no values.
+func matchAny(values []string, value string) bool {
+	return len(values) == 0 || slices.Contains(values, value)
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQueryEntries() []*Entry {
+	return []*Entry{
+		{
+			Date: "2025-03-12",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "GET",
+					OperationID: "listClusters",
+					Tag:         "Clusters",
+					Versions: []*Version{
+						{
+							Version: "2024-08-05",
+							Changes: []*Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+								{Description: "added the property 'name'", Code: "response-optional-property-added", BackwardCompatible: true},
+							},
+						},
+						{
+							Version: "2023-01-01",
+							Changes: []*Change{
+								{Description: "fixed the type", Code: "response-property-type-changed", HideFromChangelog: true},
+							},
+						},
+					},
+				},
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Tag:         "Projects",
+					Versions: []*Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date:        "2025-02-01",
+			FromVersion: "2023-01-01",
+			ToVersion:   "2024-08-05",
+			Paths: []*Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "POST",
+					OperationID: "createCluster",
+					Tag:         "Clusters",
+					Changes: []*Change{
+						{Description: "removed the request property 'id'", Code: "request-property-removed"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestQuery_Apply(t *testing.T) {
+	breaking := false
+	hidden := true
+
+	testCases := []struct {
+		name        string
+		query       *Query
+		wantChanges []string
+	}{
+		{
+			name:  "no filters",
+			query: &Query{},
+			wantChanges: []string{
+				"response-property-removed", "response-optional-property-added", "response-property-type-changed",
+				"response-property-removed", "request-property-removed",
+			},
+		},
+		{
+			name:        "date range",
+			query:       &Query{From: "2025-02-01", To: "2025-02-28"},
+			wantChanges: []string{"request-property-removed"},
+		},
+		{
+			name:        "breaking cluster changes since March",
+			query:       &Query{From: "2025-03-01", PathPrefix: "/api/atlas/v2/groups/{groupId}/clusters", BackwardCompatible: &breaking},
+			wantChanges: []string{"response-property-removed", "response-property-type-changed"},
+		},
+		{
+			name:        "version",
+			query:       &Query{Versions: []string{"2024-08-05"}},
+			wantChanges: []string{"response-property-removed", "response-optional-property-added", "request-property-removed"},
+		},
+		{
+			name:        "operation ID and tag",
+			query:       &Query{OperationIDs: []string{"listProjects", "listClusters"}, Tags: []string{"Projects"}},
+			wantChanges: []string{"response-property-removed"},
+		},
+		{
+			name:        "change code",
+			query:       &Query{ChangeCodes: []string{"request-property-removed"}},
+			wantChanges: []string{"request-property-removed"},
+		},
+		{
+			name:        "hidden",
+			query:       &Query{Hidden: &hidden},
+			wantChanges: []string{"response-property-type-changed"},
+		},
+		{
+			name:  "no match",
+			query: &Query{Tags: []string{"Teams"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := newQueryEntries()
+			var changes []string
+			for _, entry := range tc.query.Apply(entries) {
+				for _, path := range entry.Paths {
+					for _, version := range path.Versions {
+						require.NotEmpty(t, version.Changes)
+						for _, change := range version.Changes {
+							changes = append(changes, change.Code)
+						}
+					}
+					for _, change := range path.Changes {
+						changes = append(changes, change.Code)
+					}
+				}
+			}
+
+			assert.Equal(t, tc.wantChanges, changes)
+			assert.Equal(t, newQueryEntries(), entries)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+const (
+	atomNamespace = "http://www.w3.org/2005/Atom"
+	rssVersion    = "2.0"
+)
+
+// FeedConfig holds the metadata of the Atom and RSS feeds.
+type FeedConfig struct {
+	Title string
+	// Link is the URL of the changelog page, it is used to build the ID of the feed items.
+	Link string
+	// Updated is the Atom feed update time when there are no changes, the current time if zero.
+	Updated time.Time
+}
+
+type atomFeed struct {
+	XMLName xml.Name     `xml:"feed"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Link    atomLink     `xml:"link"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string     `xml:"title"`
+	Link        string     `xml:"link"`
+	Description string     `xml:"description"`
+	Items       []*rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// feedItem is a feed entry with the changes released on a date.
+type feedItem struct {
+	date    time.Time
+	title   string
+	link    string
+	content string
+}
+
+// NewAtom renders the entries as an Atom feed with one entry per date.
+func NewAtom(entries []*changelog.Entry, config *FeedConfig) ([]byte, error) {
+	items, err := newFeedItems(entries, config)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &atomFeed{
+		XMLNS: atomNamespace,
+		ID:    config.Link,
+		Title: config.Title,
+		Link:  atomLink{Href: config.Link},
+	}
+
+	for _, item := range items {
+		feed.Entries = append(feed.Entries, &atomEntry{
+			ID:      item.link,
+			Title:   item.title,
+			Updated: item.date.Format(time.RFC3339),
+			Link:    atomLink{Href: item.link},
+			Content: atomContent{Type: "html", Value: item.content},
+		})
+	}
+
+	// The feed is updated when the latest changes are released. Atom requires the update time even without changes.
+	updated := config.Updated
+	switch {
+	case len(items) > 0:
+		updated = items[0].date
+	case updated.IsZero():
+		updated = time.Now().UTC()
+	}
+	feed.Updated = updated.Format(time.RFC3339)
+
+	return marshalFeed(feed)
+}
+
+// NewRSS renders the entries as an RSS 2.0 feed with one item per date.
+func NewRSS(entries []*changelog.Entry, config *FeedConfig) ([]byte, error) {
+	items, err := newFeedItems(entries, config)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &rssFeed{
+		Version: rssVersion,
+		Channel: rssChannel{
+			Title:       config.Title,
+			Link:        config.Link,
+			Description: config.Title,
+		},
+	}
+
+	for _, item := range items {
+		feed.Channel.Items = append(feed.Channel.Items, &rssItem{
+			Title:       item.title,
+			Link:        item.link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.link},
+			PubDate:     item.date.Format(time.RFC1123Z),
+			Description: item.content,
+		})
+	}
+
+	return marshalFeed(feed)
+}
+
+func newFeedItems(entries []*changelog.Entry, config *FeedConfig) ([]*feedItem, error) {
+	if config == nil || config.Title == "" || config.Link == "" {
+		return nil, errors.New("the feed title and link are required")
+	}
+
+	sections := NewSections(entries)
+	items := make([]*feedItem, 0, len(sections))
+	for _, dateSection := range sections {
+		date, err := time.Parse(time.DateOnly, dateSection.Date)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := newHTMLDateSection(dateSection)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &feedItem{
+			date:    date,
+			title:   config.Title + " " + dateSection.Date,
+			link:    strings.TrimSuffix(config.Link, "#") + "#" + dateSection.Date,
+			content: content,
+		})
+	}
+
+	return items, nil
+}
+
+func marshalFeed(feed any) ([]byte, error) {
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
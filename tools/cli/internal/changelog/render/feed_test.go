@@ -0,0 +1,77 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var feedConfig = &FeedConfig{Title: "API Changelog", Link: "https://example.com/changelog"}
+
+func TestNewAtom(t *testing.T) {
+	b, err := NewAtom(newEntries(), feedConfig)
+	require.NoError(t, err)
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(b, &feed))
+	assert.Equal(t, "https://example.com/changelog", feed.ID)
+	assert.Equal(t, "2025-03-12T00:00:00Z", feed.Updated)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, "https://example.com/changelog#2025-03-12", feed.Entries[0].ID)
+	assert.Equal(t, "API Changelog 2025-03-12", feed.Entries[0].Title)
+	assert.Equal(t, "html", feed.Entries[0].Content.Type)
+	assert.Contains(t, feed.Entries[0].Content.Value, `<li class="breaking">`)
+}
+
+func TestNewAtom_Empty(t *testing.T) {
+	config := &FeedConfig{Title: "API Changelog", Link: "https://example.com/changelog", Updated: time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)}
+	b, err := NewAtom(nil, config)
+	require.NoError(t, err)
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(b, &feed))
+	assert.Equal(t, "2025-03-12T00:00:00Z", feed.Updated)
+	assert.Empty(t, feed.Entries)
+
+	// The current time is used without a configured update time
+	b, err = NewAtom(nil, feedConfig)
+	require.NoError(t, err)
+	require.NoError(t, xml.Unmarshal(b, &feed))
+	_, err = time.Parse(time.RFC3339, feed.Updated)
+	require.NoError(t, err)
+}
+
+func TestNewRSS(t *testing.T) {
+	b, err := NewRSS(newEntries(), feedConfig)
+	require.NoError(t, err)
+
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(b, &feed))
+	assert.Equal(t, "2.0", feed.Version)
+	require.Len(t, feed.Channel.Items, 1)
+	assert.Equal(t, "Wed, 12 Mar 2025 00:00:00 +0000", feed.Channel.Items[0].PubDate)
+	assert.Equal(t, "https://example.com/changelog#2025-03-12", feed.Channel.Items[0].GUID.Value)
+	assert.Contains(t, feed.Channel.Items[0].Description, "createProject")
+}
+
+func TestNewFeed_MissingConfig(t *testing.T) {
+	_, err := NewRSS(newEntries(), &FeedConfig{Title: "API Changelog"})
+	require.EqualError(t, err, "the feed title and link are required")
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+const htmlDateTemplate = `{{define "date"}}<section class="changelog-date" id="{{.Date}}">
+<h2>{{.Date}}</h2>
+{{- range .Tags}}
+<h3>{{.Tag}}</h3>
+{{- range .Versions}}
+<h4>{{versionHeading .}}</h4>
+<ul>
+{{- range .Changes}}
+<li{{if .Breaking}} class="breaking"{{end}}>{{if .Breaking}}<strong>Breaking:</strong> {{end}}<code>{{.Method}} {{.Path}}</code>
+{{- if .OperationID}} ({{.OperationID}}){{end}}: {{.Description}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- end}}
+</section>
+{{end}}`
+
+const htmlDocumentTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+li.breaking { color: #b51818; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Sections}}{{template "date" .}}{{end -}}
+</body>
+</html>
+`
+
+var htmlTemplate = template.Must(template.New("document").
+	Funcs(template.FuncMap{"versionHeading": versionHeading}).
+	Parse(htmlDateTemplate + htmlDocumentTemplate))
+
+// NewHTML renders the entries as an HTML document grouped by date, tag and version.
+// Changes that are not backward compatible are highlighted with the "breaking" class.
+func NewHTML(entries []*changelog.Entry) ([]byte, error) {
+	var b bytes.Buffer
+	err := htmlTemplate.Execute(&b, struct {
+		Title    string
+		Sections []*DateSection
+	}{
+		Title:    title,
+		Sections: NewSections(entries),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// newHTMLDateSection renders the changes of a date as an HTML fragment.
+func newHTMLDateSection(dateSection *DateSection) (string, error) {
+	var b bytes.Buffer
+	if err := htmlTemplate.ExecuteTemplate(&b, "date", dateSection); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
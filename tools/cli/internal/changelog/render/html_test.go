@@ -0,0 +1,37 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTML(t *testing.T) {
+	b, err := NewHTML(newEntries())
+	require.NoError(t, err)
+
+	html := string(b)
+	assert.Contains(t, html, "<title>API Changelog</title>")
+	assert.Contains(t, html, `<section class="changelog-date" id="2025-03-12">`)
+	assert.Contains(t, html, "<h4>2025-09-22 (upcoming)</h4>")
+	assert.Contains(t, html,
+		`<li class="breaking"><strong>Breaking:</strong> <code>GET /api/atlas/v2/groups/{groupId}/clusters</code> `+
+			`(listClusters): removed the property &#39;id&#39;</li>`)
+	assert.Contains(t, html, "<li><code>POST /api/atlas/v2/groups</code> (createProject): endpoint added</li>")
+	assert.NotContains(t, html, "hidden change")
+}
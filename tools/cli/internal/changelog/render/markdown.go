@@ -0,0 +1,65 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+const title = "API Changelog"
+
+// NewMarkdown renders the entries as a Markdown document grouped by date, tag and version.
+// Changes that are not backward compatible are highlighted in bold.
+func NewMarkdown(entries []*changelog.Entry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+	for _, dateSection := range NewSections(entries) {
+		fmt.Fprintf(&b, "\n## %s\n", dateSection.Date)
+		for _, tagSection := range dateSection.Tags {
+			fmt.Fprintf(&b, "\n### %s\n", tagSection.Tag)
+			for _, versionSection := range tagSection.Versions {
+				fmt.Fprintf(&b, "\n#### %s\n\n", versionHeading(versionSection))
+				for _, change := range versionSection.Changes {
+					b.WriteString(markdownChange(change))
+				}
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func markdownChange(change *Change) string {
+	operation := fmt.Sprintf("`%s %s`", change.Method, change.Path)
+	if change.OperationID != "" {
+		operation += fmt.Sprintf(" (%s)", change.OperationID)
+	}
+
+	if change.Breaking {
+		return fmt.Sprintf("- **Breaking:** %s: **%s**\n", operation, change.Description)
+	}
+	return fmt.Sprintf("- %s: %s\n", operation, change.Description)
+}
+
+// versionHeading returns the version with its stability level if it is not stable, e.g. "2025-09-22 (upcoming)".
+func versionHeading(versionSection *VersionSection) string {
+	if versionSection.StabilityLevel == "" || versionSection.StabilityLevel == "stable" {
+		return versionSection.Version
+	}
+	return fmt.Sprintf("%s (%s)", versionSection.Version, versionSection.StabilityLevel)
+}
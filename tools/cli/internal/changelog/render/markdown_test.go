@@ -0,0 +1,45 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMarkdown(t *testing.T) {
+	expected := strings.Join([]string{
+		"# API Changelog",
+		"",
+		"## 2025-03-12",
+		"",
+		"### Clusters",
+		"",
+		"#### 2024-08-05",
+		"",
+		"- **Breaking:** `GET /api/atlas/v2/groups/{groupId}/clusters` (listClusters): **removed the property 'id'**",
+		"- `GET /api/atlas/v2/groups/{groupId}/clusters` (listClusters): added the optional property 'name'",
+		"",
+		"### Projects",
+		"",
+		"#### 2025-09-22 (upcoming)",
+		"",
+		"- `POST /api/atlas/v2/groups` (createProject): endpoint added",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, NewMarkdown(newEntries()))
+}
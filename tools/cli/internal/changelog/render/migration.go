@@ -0,0 +1,213 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+const (
+	requestSection   = "Request"
+	responseSection  = "Response"
+	operationSection = "Operation"
+)
+
+// migrationCategories are the categories of the changes of a migration guide section, in order.
+var migrationCategories = []string{
+	"Removed fields",
+	"Newly required fields and parameters",
+	"Enum values",
+	"Added fields",
+	"Other changes",
+}
+
+// MigrationOperation holds the changes of an operation between two versions grouped by section and category.
+type MigrationOperation struct {
+	Method      string
+	Path        string
+	OperationID string
+	// Sections maps Request, Response and Operation to the changes grouped by category.
+	Sections map[string]map[string][]*Change
+}
+
+func (o *MigrationOperation) breakingChanges() int {
+	count := 0
+	for _, categories := range o.Sections {
+		for _, changes := range categories {
+			for _, change := range changes {
+				if change.Breaking {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// NewMigrationGuide renders the paths of a version diff changelog, i.e. version-diff/<from>_<to>.json, as a Markdown
+// migration guide grouped by tag and operation. The changes of each operation are split into request, response
+// and operation sections, and grouped by category: removed fields, newly required fields and parameters, enum values,
+// added fields and other changes. Breaking changes are highlighted in bold. Changes hidden from the changelog are not included.
+func NewMigrationGuide(paths []*changelog.Path, fromVersion, toVersion string) string {
+	tags := newMigrationTags(paths)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Migration Guide from %s to %s\n", fromVersion, toVersion)
+	if len(tags) == 0 {
+		fmt.Fprintf(&b, "\nNo changes between %s and %s.\n", fromVersion, toVersion)
+		return b.String()
+	}
+
+	operations, breaking := 0, 0
+	for _, tagOperations := range tags {
+		operations += len(tagOperations)
+		for _, operation := range tagOperations {
+			breaking += operation.breakingChanges()
+		}
+	}
+	fmt.Fprintf(&b, "\nThis guide lists the changes of %d operations when moving from %s to %s, including %d breaking changes.\n",
+		operations, fromVersion, toVersion, breaking)
+
+	for _, tag := range slices.Sorted(maps.Keys(tags)) {
+		fmt.Fprintf(&b, "\n## %s\n", tag)
+		for _, operation := range tags[tag] {
+			fmt.Fprintf(&b, "\n### `%s %s`", operation.Method, operation.Path)
+			if operation.OperationID != "" {
+				fmt.Fprintf(&b, " (%s)", operation.OperationID)
+			}
+			b.WriteString("\n")
+
+			for _, section := range []string{operationSection, requestSection, responseSection} {
+				categories, ok := operation.Sections[section]
+				if !ok {
+					continue
+				}
+
+				fmt.Fprintf(&b, "\n#### %s\n", section)
+				for _, category := range migrationCategories {
+					changes, ok := categories[category]
+					if !ok {
+						continue
+					}
+
+					fmt.Fprintf(&b, "\n%s:\n\n", category)
+					for _, change := range changes {
+						if change.Breaking {
+							fmt.Fprintf(&b, "- **Breaking:** **%s**\n", change.Description)
+							continue
+						}
+						fmt.Fprintf(&b, "- %s\n", change.Description)
+					}
+				}
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// newMigrationTags groups the changes of the paths by tag and operation. Operations are sorted by path and method.
+func newMigrationTags(paths []*changelog.Path) map[string][]*MigrationOperation {
+	tags := make(map[string][]*MigrationOperation)
+	for _, path := range paths {
+		operation := &MigrationOperation{
+			Method:      path.HTTPMethod,
+			Path:        path.URI,
+			OperationID: path.OperationID,
+			Sections:    make(map[string]map[string][]*Change),
+		}
+
+		for _, change := range path.Changes {
+			if change.HideFromChangelog {
+				continue
+			}
+
+			section, category := migrationSection(change.Code), migrationCategory(change.Code)
+			if _, ok := operation.Sections[section]; !ok {
+				operation.Sections[section] = make(map[string][]*Change)
+			}
+			operation.Sections[section][category] = append(operation.Sections[section][category], &Change{
+				Method:      path.HTTPMethod,
+				Path:        path.URI,
+				OperationID: path.OperationID,
+				Description: change.Description,
+				Code:        change.Code,
+				Breaking:    !change.BackwardCompatible,
+			})
+		}
+
+		if len(operation.Sections) > 0 {
+			tags[path.Tag] = append(tags[path.Tag], operation)
+		}
+	}
+
+	for _, operations := range tags {
+		slices.SortFunc(operations, func(a, b *MigrationOperation) int {
+			return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+		})
+
+		for _, operation := range operations {
+			for _, categories := range operation.Sections {
+				for _, changes := range categories {
+					slices.SortStableFunc(changes, func(a, b *Change) int {
+						if a.Breaking != b.Breaking {
+							if a.Breaking {
+								return -1
+							}
+							return 1
+						}
+						return 0
+					})
+				}
+			}
+		}
+	}
+
+	return tags
+}
+
+// migrationSection returns the section of the change code, e.g. request-property-removed belongs to the request.
+func migrationSection(code string) string {
+	switch {
+	case strings.HasPrefix(code, "request-"), strings.HasPrefix(code, "new-") && strings.Contains(code, "-request-"):
+		return requestSection
+	case strings.HasPrefix(code, "response-"):
+		return responseSection
+	default:
+		return operationSection
+	}
+}
+
+// migrationCategory returns the category of the change code, one of migrationCategories.
+func migrationCategory(code string) string {
+	switch {
+	case strings.Contains(code, "enum-value"):
+		return migrationCategories[2]
+	case strings.HasSuffix(code, "-property-removed"), strings.HasSuffix(code, "-parameter-removed"):
+		return migrationCategories[0]
+	case strings.HasPrefix(code, "new-required-"), strings.HasSuffix(code, "-became-required"):
+		return migrationCategories[1]
+	case strings.HasPrefix(code, "new-optional-"), strings.HasSuffix(code, "-property-added"), strings.HasSuffix(code, "-parameter-added"):
+		return migrationCategories[3]
+	default:
+		return migrationCategories[4]
+	}
+}
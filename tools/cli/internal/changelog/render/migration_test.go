@@ -0,0 +1,144 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMigrationGuide(t *testing.T) {
+	paths := []*changelog.Path{
+		{
+			URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+			HTTPMethod:  "POST",
+			OperationID: "createCluster",
+			Tag:         "Clusters",
+			Changes: []*changelog.Change{
+				{Description: "added the new optional request property 'name'", Code: "new-optional-request-property", BackwardCompatible: true},
+				{Description: "removed the request property 'diskSizeGB'", Code: "request-property-removed"},
+				{Description: "added the new required request property 'replicationSpecs'", Code: "new-required-request-property"},
+				{Description: "removed the optional property 'numShards' from the response", Code: "response-optional-property-removed"},
+				{Description: "removed the enum value 'M2' of the response property 'instanceSize'", Code: "response-property-enum-value-removed"},
+				{Description: "added the enum value 'FLEX' to 'instanceSize'", Code: "response-property-enum-value-added", BackwardCompatible: true},
+				{Description: "hidden change", Code: "response-property-type-changed", HideFromChangelog: true},
+			},
+		},
+		{
+			URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+			HTTPMethod:  "GET",
+			OperationID: "listClusters",
+			Tag:         "Clusters",
+			Changes: []*changelog.Change{
+				{Description: "added the optional property 'name' to the response", Code: "response-optional-property-added", BackwardCompatible: true},
+			},
+		},
+		{
+			URI:         "/api/atlas/v2/groups",
+			HTTPMethod:  "POST",
+			OperationID: "createProject",
+			Tag:         "Projects",
+			Changes: []*changelog.Change{
+				{Description: "endpoint added", Code: "endpoint-added", BackwardCompatible: true},
+			},
+		},
+		{
+			URI:         "/api/atlas/v2/groups",
+			HTTPMethod:  "GET",
+			OperationID: "listProjects",
+			Tag:         "Projects",
+			Changes:     []*changelog.Change{{Description: "hidden change", Code: "response-property-type-changed", HideFromChangelog: true}},
+		},
+	}
+
+	expected := strings.Join([]string{
+		"# Migration Guide from 2023-02-01 to 2024-08-05",
+		"",
+		"This guide lists the changes of 3 operations when moving from 2023-02-01 to 2024-08-05, including 4 breaking changes.",
+		"",
+		"## Clusters",
+		"",
+		"### `GET /api/atlas/v2/groups/{groupId}/clusters` (listClusters)",
+		"",
+		"#### Response",
+		"",
+		"Added fields:",
+		"",
+		"- added the optional property 'name' to the response",
+		"",
+		"### `POST /api/atlas/v2/groups/{groupId}/clusters` (createCluster)",
+		"",
+		"#### Request",
+		"",
+		"Removed fields:",
+		"",
+		"- **Breaking:** **removed the request property 'diskSizeGB'**",
+		"",
+		"Newly required fields and parameters:",
+		"",
+		"- **Breaking:** **added the new required request property 'replicationSpecs'**",
+		"",
+		"Added fields:",
+		"",
+		"- added the new optional request property 'name'",
+		"",
+		"#### Response",
+		"",
+		"Removed fields:",
+		"",
+		"- **Breaking:** **removed the optional property 'numShards' from the response**",
+		"",
+		"Enum values:",
+		"",
+		"- **Breaking:** **removed the enum value 'M2' of the response property 'instanceSize'**",
+		"- added the enum value 'FLEX' to 'instanceSize'",
+		"",
+		"## Projects",
+		"",
+		"### `POST /api/atlas/v2/groups` (createProject)",
+		"",
+		"#### Operation",
+		"",
+		"Other changes:",
+		"",
+		"- endpoint added",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, NewMigrationGuide(paths, "2023-02-01", "2024-08-05"))
+}
+
+func TestNewMigrationGuide_NoChanges(t *testing.T) {
+	assert.Equal(t, "# Migration Guide from 2023-02-01 to 2024-08-05\n\nNo changes between 2023-02-01 and 2024-08-05.\n",
+		NewMigrationGuide(nil, "2023-02-01", "2024-08-05"))
+}
+
+func TestMigrationCategory(t *testing.T) {
+	testCases := map[string]string{
+		"request-parameter-removed":                     "Removed fields",
+		"response-optional-write-only-property-removed": "Removed fields",
+		"new-required-request-parameter":                "Newly required fields and parameters",
+		"request-property-became-required":              "Newly required fields and parameters",
+		"request-parameter-enum-value-removed":          "Enum values",
+		"response-required-property-added":              "Added fields",
+		"response-property-type-changed":                "Other changes",
+	}
+
+	for code, expected := range testCases {
+		assert.Equal(t, expected, migrationCategory(code), code)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render renders the API changelog entries in human-readable formats.
+package render
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+const (
+	Markdown = "markdown"
+	HTML     = "html"
+	Atom     = "atom"
+	RSS      = "rss"
+)
+
+// Formats are the supported render formats.
+var Formats = []string{Markdown, HTML, Atom, RSS}
+
+// DateSection holds the changes released on a date grouped by tag.
+type DateSection struct {
+	Date string
+	Tags []*TagSection
+}
+
+// TagSection holds the changes of a tag grouped by version.
+type TagSection struct {
+	Tag      string
+	Versions []*VersionSection
+}
+
+// VersionSection holds the changes of a version.
+type VersionSection struct {
+	Version        string
+	StabilityLevel string
+	Changes        []*Change
+}
+
+// Change is a change of an operation.
+type Change struct {
+	Method      string
+	Path        string
+	OperationID string
+	ChangeType  string
+	Description string
+	Code        string
+	Breaking    bool
+}
+
+// Render renders the entries in the given format.
+func Render(entries []*changelog.Entry, format string, feed *FeedConfig) ([]byte, error) {
+	switch format {
+	case Markdown:
+		return []byte(NewMarkdown(entries)), nil
+	case HTML:
+		return NewHTML(entries)
+	case Atom:
+		return NewAtom(entries, feed)
+	case RSS:
+		return NewRSS(entries, feed)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// NewSections groups the changes of the entries by date, tag and version.
+// Dates and versions are sorted from newest to oldest, tags alphabetically and breaking changes come first.
+// Changes hidden from the changelog are not included.
+func NewSections(entries []*changelog.Entry) []*DateSection {
+	dates := make(map[string]*DateSection)
+	tags := make(map[string]*TagSection)
+	versions := make(map[string]*VersionSection)
+
+	add := func(date, tag string, version *changelog.Version, change *Change) {
+		dateSection, ok := dates[date]
+		if !ok {
+			dateSection = &DateSection{Date: date}
+			dates[date] = dateSection
+		}
+
+		tagKey := date + "/" + tag
+		tagSection, ok := tags[tagKey]
+		if !ok {
+			tagSection = &TagSection{Tag: tag}
+			tags[tagKey] = tagSection
+			dateSection.Tags = append(dateSection.Tags, tagSection)
+		}
+
+		versionKey := tagKey + "/" + version.Version
+		versionSection, ok := versions[versionKey]
+		if !ok {
+			versionSection = &VersionSection{Version: version.Version, StabilityLevel: version.StabilityLevel}
+			versions[versionKey] = versionSection
+			tagSection.Versions = append(tagSection.Versions, versionSection)
+		}
+
+		versionSection.Changes = append(versionSection.Changes, change)
+	}
+
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			for _, version := range pathVersions(entry, path) {
+				for _, change := range version.Changes {
+					if change.HideFromChangelog {
+						continue
+					}
+
+					add(entry.Date, path.Tag, version, &Change{
+						Method:      path.HTTPMethod,
+						Path:        path.URI,
+						OperationID: path.OperationID,
+						ChangeType:  version.ChangeType,
+						Description: change.Description,
+						Code:        change.Code,
+						Breaking:    !change.BackwardCompatible,
+					})
+				}
+			}
+		}
+	}
+
+	sections := make([]*DateSection, 0, len(dates))
+	for _, dateSection := range dates {
+		sortDateSection(dateSection)
+		sections = append(sections, dateSection)
+	}
+	slices.SortFunc(sections, func(a, b *DateSection) int {
+		return cmp.Compare(b.Date, a.Date)
+	})
+
+	return sections
+}
+
+// pathVersions returns the versions of the path.
+// The paths of a version diff changelog hold the changes of the entry ToVersion.
+func pathVersions(entry *changelog.Entry, path *changelog.Path) []*changelog.Version {
+	if len(path.Changes) == 0 {
+		return path.Versions
+	}
+
+	return append(path.Versions, &changelog.Version{
+		Version:        entry.ToVersion,
+		StabilityLevel: path.StabilityLevel,
+		ChangeType:     path.ChangeType,
+		Changes:        path.Changes,
+	})
+}
+
+func sortDateSection(dateSection *DateSection) {
+	slices.SortFunc(dateSection.Tags, func(a, b *TagSection) int {
+		return cmp.Compare(a.Tag, b.Tag)
+	})
+
+	for _, tagSection := range dateSection.Tags {
+		slices.SortFunc(tagSection.Versions, func(a, b *VersionSection) int {
+			return cmp.Compare(b.Version, a.Version)
+		})
+
+		for _, versionSection := range tagSection.Versions {
+			slices.SortStableFunc(versionSection.Changes, func(a, b *Change) int {
+				if a.Breaking != b.Breaking {
+					if a.Breaking {
+						return -1
+					}
+					return 1
+				}
+				return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+			})
+		}
+	}
+}
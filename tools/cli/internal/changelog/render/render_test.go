@@ -0,0 +1,140 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEntries() []*changelog.Entry {
+	return []*changelog.Entry{
+		{
+			Date: "2025-03-12",
+			Paths: []*changelog.Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "GET",
+					OperationID: "listClusters",
+					Tag:         "Clusters",
+					Versions: []*changelog.Version{
+						{
+							Version:        "2024-08-05",
+							StabilityLevel: "stable",
+							ChangeType:     "update",
+							Changes: []*changelog.Change{
+								{Description: "added the optional property 'name'", Code: "response-optional-property-added", BackwardCompatible: true},
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+								{Description: "hidden change", Code: "response-property-removed", HideFromChangelog: true},
+							},
+						},
+					},
+				},
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "POST",
+					OperationID: "createProject",
+					Tag:         "Projects",
+					Versions: []*changelog.Version{
+						{
+							Version:        "2025-09-22",
+							StabilityLevel: "upcoming",
+							ChangeType:     "release",
+							Changes: []*changelog.Change{
+								{Description: "endpoint added", Code: "endpoint-added", BackwardCompatible: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Date: "2025-03-10",
+			Paths: []*changelog.Path{
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Tag:         "Projects",
+					Versions: []*changelog.Version{
+						{
+							Version:        "2023-01-01",
+							StabilityLevel: "stable",
+							ChangeType:     "update",
+							Changes: []*changelog.Change{
+								{Description: "hidden change", Code: "response-property-removed", HideFromChangelog: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSections(t *testing.T) {
+	sections := NewSections(newEntries())
+	require.Len(t, sections, 1)
+	assert.Equal(t, "2025-03-12", sections[0].Date)
+	require.Len(t, sections[0].Tags, 2)
+	assert.Equal(t, "Clusters", sections[0].Tags[0].Tag)
+	assert.Equal(t, "Projects", sections[0].Tags[1].Tag)
+
+	changes := sections[0].Tags[0].Versions[0].Changes
+	require.Len(t, changes, 2)
+	assert.True(t, changes[0].Breaking)
+	assert.Equal(t, "removed the property 'id'", changes[0].Description)
+	assert.False(t, changes[1].Breaking)
+}
+
+func TestNewSections_VersionDiff(t *testing.T) {
+	entries := []*changelog.Entry{
+		{
+			Date:        "2025-03-12",
+			FromVersion: "2023-01-01",
+			ToVersion:   "2024-08-05",
+			Paths: []*changelog.Path{
+				{
+					URI:        "/api/atlas/v2/groups",
+					HTTPMethod: "GET",
+					Tag:        "Projects",
+					ChangeType: "update",
+					Changes:    []*changelog.Change{{Description: "removed the property 'id'", Code: "response-property-removed"}},
+				},
+			},
+		},
+	}
+
+	sections := NewSections(entries)
+	require.Len(t, sections, 1)
+	assert.Equal(t, "2024-08-05", sections[0].Tags[0].Versions[0].Version)
+	assert.Len(t, sections[0].Tags[0].Versions[0].Changes, 1)
+}
+
+func TestRender(t *testing.T) {
+	for _, format := range Formats {
+		t.Run(format, func(t *testing.T) {
+			b, err := Render(newEntries(), format, &FeedConfig{Title: "API Changelog", Link: "https://example.com/changelog"})
+			require.NoError(t, err)
+			assert.Contains(t, string(b), "listClusters")
+		})
+	}
+
+	_, err := Render(newEntries(), "pdf", nil)
+	require.EqualError(t, err, `unsupported format "pdf"`)
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"sort"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// NewPathsBetweenSpecs generates the changes between two spec files, grouped by operation.
+// It runs the same checks, severity levels, message transformations and squashing as the changelog generation,
+// without metadata, base changelog or versions. The exemptions are skipped when exceptionFilePath is empty.
+// The returned paths include the hidden changes and are sorted by path and HTTP method.
+func NewPathsBetweenSpecs(baseSpecPath, revisionSpecPath, exceptionFilePath string) ([]*Path, error) {
+	loader := openapi.NewOpenAPI3().WithExcludedPrivatePaths()
+	baseSpec, err := loader.CreateOpenAPISpecFromPath(baseSpecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionSpec, err := loader.CreateOpenAPISpecFromPath(revisionSpecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Changelog{
+		Base:     baseSpec,
+		Revision: revisionSpec,
+		Config: checker.NewConfig(
+			checker.GetAllChecks()).WithSeverityLevels(breakingChangesAdditionalCheckers).WithDeprecation(deprecationDaysBeta, deprecationDaysStable),
+		ExemptionFilePath: exceptionFilePath,
+		OasDiff: openapi.NewOasDiffWithSpecInfo(baseSpec, revisionSpec, &diff.Config{
+			IncludePathParams: true,
+		}),
+	}
+
+	changes, err := m.newOasDiffEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	conf := outputfilter.NewOperationConfigs(baseSpec, revisionSpec)
+	paths := make([]*Path, 0)
+	for _, change := range changes {
+		pathEntry := newPathEntry(&paths, change.Path, change.Operation)
+		pathEntry.Versions = nil
+		pathEntry.OperationID = change.OperationID
+		if operationConf, ok := conf[change.OperationID]; ok {
+			pathEntry.Tag = operationConf.Tag()
+			pathEntry.OwnerTeam = operationConf.OwnerTeam()
+		}
+
+		pathEntry.Changes = append(pathEntry.Changes, &Change{
+			Description:        change.Text,
+			Code:               change.ID,
+			BackwardCompatible: change.LevelWithDefault() < int(checker.ERR),
+			HideFromChangelog:  change.HideFromChangelog,
+		})
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].URI != paths[j].URI {
+			return paths[i].URI < paths[j].URI
+		}
+		return paths[i].HTTPMethod < paths[j].HTTPMethod
+	})
+
+	return paths, nil
+}
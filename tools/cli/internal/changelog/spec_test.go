@@ -0,0 +1,141 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPathsBetweenSpecs(t *testing.T) {
+	testPath := "../../test/data/changelog/new-api-version"
+	paths, err := NewPathsBetweenSpecs(testPath+"/base/openapi-2024-05-30.json", testPath+"/revision/openapi-2024-08-05.json", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	assert.Equal(t, "/api/atlas/v2/groups/{groupId}/clusters", paths[0].URI)
+	assert.Equal(t, "GET", paths[0].HTTPMethod)
+	assert.Equal(t, "listClusters", paths[0].OperationID)
+	assert.Equal(t, "Clusters", paths[0].Tag)
+	assert.Empty(t, paths[0].Versions)
+	assert.ElementsMatch(t, []*Change{
+		{
+			Description:        "removed the media type 'application/vnd.atlas.2023-02-01+json' for the response with the status '200'",
+			Code:               "response-media-type-removed",
+			BackwardCompatible: false,
+		},
+		{
+			Description:        "added the media type 'application/vnd.atlas.2024-08-05+json' for the response with the status '200'",
+			Code:               "response-media-type-added",
+			BackwardCompatible: true,
+		},
+	}, paths[0].Changes)
+
+	for i := 1; i < len(paths); i++ {
+		assert.LessOrEqual(t, paths[i-1].URI, paths[i].URI)
+	}
+}
+
+func TestNewPathsBetweenSpecs_SameSpec(t *testing.T) {
+	specPath := "../../test/data/changelog/new-api-version/revision/openapi-2024-08-05.json"
+	paths, err := NewPathsBetweenSpecs(specPath, specPath, "")
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
+
+func TestNewPathsBetweenSpecs_MissingSpec(t *testing.T) {
+	specPath := "../../test/data/changelog/new-api-version/revision/openapi-2024-08-05.json"
+	_, err := NewPathsBetweenSpecs("missing.json", specPath, "")
+	require.Error(t, err)
+}
+
+func TestNewPathsBetweenSpecs_Language(t *testing.T) {
+	dir := t.TempDir()
+	basePath := writeEnumSpec(t, dir, "base.json", `"A"`)
+	revisionPath := writeEnumSpec(t, dir, "revision.json", `"A", "B", "C"`)
+
+	testCases := []struct {
+		lang        string
+		description string
+	}{
+		{
+			lang:        "en",
+			description: "added the new 'B, C' enum values to the 'state' response property",
+		},
+		{
+			lang:        "ru",
+			description: "добавлены новые enum значения 'B, C' в поле ответа 'state'",
+		},
+		{
+			lang:        "pt-br",
+			description: "os novos valores 'B, C' do enum foram adicionados à propriedade de resposta 'state'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.lang, func(t *testing.T) {
+			require.NoError(t, outputfilter.SetLanguage(tc.lang))
+			t.Cleanup(func() { _ = outputfilter.SetLanguage("") })
+
+			paths, err := NewPathsBetweenSpecs(basePath, revisionPath, "")
+			require.NoError(t, err)
+			require.Len(t, paths, 1)
+			assert.Equal(t, []*Change{
+				{
+					Description:        tc.description,
+					Code:               "response-property-enum-value-added",
+					BackwardCompatible: true,
+				},
+			}, paths[0].Changes)
+		})
+	}
+}
+
+func writeEnumSpec(t *testing.T, dir, name, enum string) string {
+	t.Helper()
+	spec := fmt.Sprintf(`{
+  "openapi": "3.0.1",
+  "info": {"title": "MongoDB Atlas Administration API", "version": "2.0"},
+  "paths": {
+    "/api/atlas/v2/groups/{groupId}/things": {
+      "get": {
+        "operationId": "listThings",
+        "tags": ["Things"],
+        "parameters": [{"name": "groupId", "in": "path", "required": true, "schema": {"type": "string"}}],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "content": {
+              "application/vnd.atlas.2024-08-05+json": {
+                "x-xgen-version": "2024-08-05",
+                "schema": {"type": "object", "properties": {"state": {"type": "string", "enum": [%s]}}}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`, enum)
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o600))
+	return path
+}
@@ -50,7 +50,7 @@ func (m *Changelog) newOasDiffEntriesFromSunsetEndpoints(
 			changes = append(changes, &outputfilter.OasDiffEntry{
 				Date:        config.Revision.Sunset,
 				ID:          endpointRemovedCode,
-				Text:        fmt.Sprintf("endpoint with API Version '%s' was removed as it has reached its sunset date '%s'", version, config.Revision.Sunset),
+				Text:        outputfilter.Localize(endpointRemovedCode, version, config.Revision.Sunset),
 				Level:       int(checker.ERR),
 				Operation:   config.Revision.HTTPMethod,
 				OperationID: operationID,
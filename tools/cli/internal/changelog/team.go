@@ -0,0 +1,83 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	ownerTeamExtension = "x-xgen-owner-team"
+
+	// UnknownOwnerTeam is the team of the paths without owner team.
+	UnknownOwnerTeam = "unknown"
+)
+
+// NewOwnerTeams returns the x-xgen-owner-team of each operation in the spec, mapped by operation ID.
+// The published specs don't include the extension, so the owner teams are read from the raw spec.
+func NewOwnerTeams(spec *openapi3.T) map[string]string {
+	ownerTeams := make(map[string]string)
+	if spec == nil || spec.Paths == nil {
+		return ownerTeams
+	}
+
+	for _, pathItem := range spec.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			if team, ok := op.Extensions[ownerTeamExtension].(string); ok && op.OperationID != "" && team != "" {
+				ownerTeams[op.OperationID] = team
+			}
+		}
+	}
+
+	return ownerTeams
+}
+
+// SetOwnerTeams sets the owner team of the paths without one from the owner teams mapped by operation ID.
+func SetOwnerTeams(entries []*Entry, ownerTeams map[string]string) {
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			if path.OwnerTeam == "" {
+				path.OwnerTeam = ownerTeams[path.OperationID]
+			}
+		}
+	}
+}
+
+// SplitByTeam returns the entries of each owner team, including the hidden changes.
+// The paths without owner team are assigned to UnknownOwnerTeam. The input entries are not modified.
+func SplitByTeam(entries []*Entry) map[string][]*Entry {
+	teams := make(map[string][]*Entry)
+	for _, entry := range entries {
+		paths := make(map[string][]*Path)
+		for _, path := range entry.Paths {
+			team := path.OwnerTeam
+			if team == "" {
+				team = UnknownOwnerTeam
+			}
+			paths[team] = append(paths[team], path)
+		}
+
+		for team, teamPaths := range paths {
+			teams[team] = append(teams[team], &Entry{
+				Date:        entry.Date,
+				Paths:       teamPaths,
+				FromVersion: entry.FromVersion,
+				ToVersion:   entry.ToVersion,
+			})
+		}
+	}
+
+	return teams
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOwnerTeams(t *testing.T) {
+	spec := &openapi3.T{
+		Paths: openapi3.NewPaths(
+			openapi3.WithPath("/api/atlas/v2/groups/{groupId}/clusters", &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					OperationID: "listClusters",
+					Extensions:  map[string]any{"x-xgen-owner-team": "Atlas Dedicated"},
+				},
+				Post: &openapi3.Operation{
+					OperationID: "createCluster",
+				},
+			}),
+			openapi3.WithPath("/api/atlas/v2/groups", &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					OperationID: "listProjects",
+					Extensions:  map[string]any{"x-xgen-owner-team": "IAM Authorization"},
+				},
+			}),
+		),
+	}
+
+	assert.Equal(t, map[string]string{
+		"listClusters": "Atlas Dedicated",
+		"listProjects": "IAM Authorization",
+	}, NewOwnerTeams(spec))
+	assert.Empty(t, NewOwnerTeams(nil))
+}
+
+func TestSetOwnerTeams(t *testing.T) {
+	entries := newQueryEntries()
+	entries[0].Paths[1].OwnerTeam = "Existing Team"
+
+	SetOwnerTeams(entries, map[string]string{
+		"listClusters":  "Atlas Dedicated",
+		"createCluster": "Atlas Dedicated",
+		"listProjects":  "IAM Authorization",
+	})
+
+	assert.Equal(t, "Atlas Dedicated", entries[0].Paths[0].OwnerTeam)
+	assert.Equal(t, "Existing Team", entries[0].Paths[1].OwnerTeam)
+	assert.Equal(t, "Atlas Dedicated", entries[1].Paths[0].OwnerTeam)
+}
+
+func TestSplitByTeam(t *testing.T) {
+	entries := newQueryEntries()
+	SetOwnerTeams(entries, map[string]string{"listClusters": "Atlas Dedicated", "createCluster": "Atlas Dedicated"})
+
+	teams := SplitByTeam(entries)
+	require.Len(t, teams, 2)
+
+	dedicated := teams["Atlas Dedicated"]
+	require.Len(t, dedicated, 2)
+	assert.Equal(t, "2025-03-12", dedicated[0].Date)
+	require.Len(t, dedicated[0].Paths, 1)
+	assert.Equal(t, "listClusters", dedicated[0].Paths[0].OperationID)
+	// hidden changes are kept for the team review
+	assert.True(t, dedicated[0].Paths[0].Versions[1].Changes[0].HideFromChangelog)
+	assert.Equal(t, "2024-08-05", dedicated[1].ToVersion)
+
+	unknown := teams[UnknownOwnerTeam]
+	require.Len(t, unknown, 1)
+	require.Len(t, unknown[0].Paths, 1)
+	assert.Equal(t, "listProjects", unknown[0].Paths[0].OperationID)
+
+	// the input entries are not modified
+	assert.Len(t, entries[0].Paths, 2)
+}
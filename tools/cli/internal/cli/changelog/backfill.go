@@ -0,0 +1,201 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type BackfillOpts struct {
+	fs              afero.Fs
+	snapshotPaths   []string
+	snapshotsPath   string
+	exceptionsPaths string
+	outputPath      string
+	ownerTeamsSpec  string
+	rulesPath       string
+	language        string
+}
+
+func (o *BackfillOpts) Run() error {
+	if err := setMessageRules(o.fs, o.rulesPath); err != nil {
+		return err
+	}
+
+	if err := outputfilter.SetLanguage(o.language); err != nil {
+		return err
+	}
+
+	snapshots, err := o.newSnapshots()
+	if err != nil {
+		return err
+	}
+
+	entries, err := changelog.NewBackfillEntries(snapshots, o.exceptionsPaths)
+	if err != nil {
+		return err
+	}
+
+	if err := setOwnerTeams(entries, o.ownerTeamsSpec); err != nil {
+		return err
+	}
+
+	last := snapshots[len(snapshots)-1]
+	versionedEntries, err := changelog.NewEntriesBetweenRevisionVersionsWithRunDate(last.Path, o.exceptionsPaths, last.RunDate)
+	if err != nil {
+		return err
+	}
+
+	return saveChangelog(o.fs, o.outputPath, entries, versionedEntries)
+}
+
+// newSnapshots returns the snapshots sorted by run date.
+// The run date of a snapshot is the name of its dated subfolder or the run date of its metadata.json.
+func (o *BackfillOpts) newSnapshots() ([]*changelog.Snapshot, error) {
+	if o.snapshotsPath != "" {
+		return o.newSnapshotsFromDatedFolders()
+	}
+
+	snapshots := make([]*changelog.Snapshot, 0, len(o.snapshotPaths))
+	for _, path := range o.snapshotPaths {
+		contents, err := afero.ReadFile(o.fs, fmt.Sprintf("%s/%s", path, metadataFileName))
+		if err != nil {
+			return nil, err
+		}
+
+		var metadata *changelog.Metadata
+		if err := json.Unmarshal(contents, &metadata); err != nil {
+			return nil, err
+		}
+
+		if _, err := time.Parse(time.DateOnly, metadata.RunDate); err != nil {
+			return nil, fmt.Errorf("invalid run date %q in the metadata of the snapshot %s, use the format YYYY-MM-DD", metadata.RunDate, path)
+		}
+
+		if len(snapshots) > 0 && metadata.RunDate <= snapshots[len(snapshots)-1].RunDate {
+			previous := snapshots[len(snapshots)-1]
+			return nil, fmt.Errorf("the snapshots must be sorted by run date: %s (%s) is not after %s (%s)",
+				path, metadata.RunDate, previous.Path, previous.RunDate)
+		}
+
+		snapshots = append(snapshots, &changelog.Snapshot{Path: path, RunDate: metadata.RunDate})
+	}
+
+	return snapshots, nil
+}
+
+// newSnapshotsFromDatedFolders returns a snapshot for each subfolder named after its run date (YYYY-MM-DD).
+// Other files and folders are ignored.
+func (o *BackfillOpts) newSnapshotsFromDatedFolders() ([]*changelog.Snapshot, error) {
+	files, err := afero.ReadDir(o.fs, o.snapshotsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// ReadDir returns the files sorted by name, so the dated folders are sorted by run date
+	snapshots := make([]*changelog.Snapshot, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+
+		if _, err := time.Parse(time.DateOnly, file.Name()); err != nil {
+			continue
+		}
+
+		snapshots = append(snapshots, &changelog.Snapshot{
+			Path:    fmt.Sprintf("%s/%s", o.snapshotsPath, file.Name()),
+			RunDate: file.Name(),
+		})
+	}
+
+	return snapshots, nil
+}
+
+func (o *BackfillOpts) PreRunE(args []string) error {
+	o.snapshotPaths = args
+	if (len(o.snapshotPaths) == 0) == (o.snapshotsPath == "") {
+		return fmt.Errorf("provide either the snapshot folders as arguments or the flag %s", flag.SnapshotsDir)
+	}
+
+	if len(o.snapshotPaths) == 1 {
+		return errors.New("at least two snapshots are required to backfill the changelog")
+	}
+
+	if _, err := o.fs.Stat(o.exceptionsPaths); err != nil {
+		return err
+	}
+
+	if o.ownerTeamsSpec != "" {
+		if _, err := o.fs.Stat(o.ownerTeamsSpec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BackfillBuilder builds the changelog backfill command with the following signature:
+// changelog backfill -e exemptions.yaml -o changelog snapshot_folder...
+func BackfillBuilder() *cobra.Command {
+	opts := &BackfillOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "backfill -e exemptions.yaml -o output_folder [snapshot_folder...]",
+		Short: "Generate the changelog from scratch from a series of spec snapshots.",
+		Long: `Generate the changelog from scratch from a series of spec snapshots, as if it had been generated at the run date of
+each snapshot. A snapshot is a folder with the metadata.json and openapi-<version>.json files, like the revision folder
+of the create command.
+
+Provide either the snapshot folders sorted by run date as arguments, using the runDate of their metadata.json, or
+a folder with one subfolder per run date (YYYY-MM-DD) with --snapshots-dir.
+
+The output folder has the same files as the create command, and can be used as base folder of the next changelog.`,
+		Example: `  # Backfill the changelog from the dated subfolders of the snapshots folder:
+  foascli changelog backfill -e exemptions.yaml -o changelog --snapshots-dir snapshots
+
+  # Backfill the changelog from snapshot folders sorted by the runDate of their metadata.json:
+  foascli changelog backfill -e exemptions.yaml -o changelog snapshots/first snapshots/second snapshots/third`,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.snapshotsPath, flag.SnapshotsDir, "", usage.SnapshotsDir)
+	cmd.Flags().StringVarP(&opts.exceptionsPaths, flag.ExemptionFilePath, flag.ExemptionFilePathShort, "", usage.ExemptionFilePath)
+	cmd.Flags().StringVarP(&opts.ownerTeamsSpec, flag.Spec, flag.SpecShort, "", usage.OwnerTeamsSpec)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVar(&opts.rulesPath, flag.Rules, "", usage.MessageRules)
+	cmd.Flags().StringVar(&opts.language, flag.Language, "", usage.Language)
+
+	_ = cmd.MarkFlagRequired(flag.ExemptionFilePath)
+	_ = cmd.MarkFlagRequired(flag.Output)
+	return cmd
+}
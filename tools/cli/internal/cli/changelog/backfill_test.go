@@ -0,0 +1,161 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfill_Run(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	fs := afero.NewMemMapFs()
+	opts := &BackfillOpts{
+		fs:              afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(afero.NewOsFs()), fs),
+		snapshotPaths:   []string{testPath + "/base", testPath + "/revision"},
+		exceptionsPaths: testPath + "/exemptions.yaml",
+		outputPath:      "output",
+	}
+
+	require.NoError(t, opts.Run())
+
+	for _, fileName := range []string{
+		"output/changelog.json",
+		"output/internal/changelog-all.json",
+		"output/version-diff/2024-05-30_2024-08-05.json",
+	} {
+		exists, err := afero.Exists(fs, fileName)
+		require.NoError(t, err)
+		assert.True(t, exists, fileName)
+	}
+}
+
+func TestBackfill_RunWithRules(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	fs := afero.NewMemMapFs()
+	defaultRules, err := os.ReadFile("../../../internal/changelog/outputfilter/message_rules.yaml")
+	require.NoError(t, err)
+	rules := string(defaultRules) + `  - name: discriminator-mapping-changed
+    pattern: '^mapped value for discriminator key'
+    replacement: 'mapping of the discriminator key'
+`
+	require.NoError(t, afero.WriteFile(fs, "rules.yaml", []byte(rules), 0o600))
+	t.Cleanup(func() { outputfilter.SetMessageRules(nil) })
+
+	opts := &BackfillOpts{
+		fs:              afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(afero.NewOsFs()), fs),
+		snapshotPaths:   []string{testPath + "/base", testPath + "/revision"},
+		exceptionsPaths: testPath + "/exemptions.yaml",
+		outputPath:      "output",
+		rulesPath:       "rules.yaml",
+	}
+
+	require.NoError(t, opts.Run())
+
+	b, err := afero.ReadFile(fs, "output/changelog.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "mapping of the discriminator key 'AWS' changed")
+	assert.NotContains(t, string(b), "mapped value for discriminator key")
+}
+
+func TestBackfill_NewSnapshots(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "first/metadata.json", []byte(`{"runDate": "2024-08-07", "versions": ["2023-01-01"]}`), 0o600))
+	require.NoError(t, afero.WriteFile(fs, "second/metadata.json", []byte(`{"runDate": "2024-08-08", "versions": ["2023-01-01"]}`), 0o600))
+
+	opts := &BackfillOpts{fs: fs, snapshotPaths: []string{"first", "second"}}
+	snapshots, err := opts.newSnapshots()
+	require.NoError(t, err)
+	assert.Equal(t, []*changelog.Snapshot{
+		{Path: "first", RunDate: "2024-08-07"},
+		{Path: "second", RunDate: "2024-08-08"},
+	}, snapshots)
+
+	opts.snapshotPaths = []string{"second", "first"}
+	_, err = opts.newSnapshots()
+	require.EqualError(t, err, "the snapshots must be sorted by run date: first (2024-08-07) is not after second (2024-08-08)")
+}
+
+func TestBackfill_NewSnapshotsFromDatedFolders(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, path := range []string{"snapshots/2024-08-08", "snapshots/2024-08-07", "snapshots/latest"} {
+		require.NoError(t, fs.MkdirAll(path, 0o755))
+	}
+	require.NoError(t, afero.WriteFile(fs, "snapshots/2024-08-09", []byte("not a folder"), 0o600))
+
+	opts := &BackfillOpts{fs: fs, snapshotsPath: "snapshots"}
+	snapshots, err := opts.newSnapshots()
+	require.NoError(t, err)
+	assert.Equal(t, []*changelog.Snapshot{
+		{Path: "snapshots/2024-08-07", RunDate: "2024-08-07"},
+		{Path: "snapshots/2024-08-08", RunDate: "2024-08-08"},
+	}, snapshots)
+}
+
+func TestBackfill_PreRun(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "exemptions.yaml", []byte{}, 0o600))
+
+	testCases := []struct {
+		name        string
+		opts        *BackfillOpts
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "no snapshots",
+			opts:        &BackfillOpts{fs: fs, exceptionsPaths: "exemptions.yaml"},
+			expectedErr: "provide either the snapshot folders as arguments or the flag snapshots-dir",
+		},
+		{
+			name:        "snapshot folders and snapshots dir",
+			opts:        &BackfillOpts{fs: fs, exceptionsPaths: "exemptions.yaml", snapshotsPath: "snapshots"},
+			args:        []string{"first", "second"},
+			expectedErr: "provide either the snapshot folders as arguments or the flag snapshots-dir",
+		},
+		{
+			name:        "single snapshot folder",
+			opts:        &BackfillOpts{fs: fs, exceptionsPaths: "exemptions.yaml"},
+			args:        []string{"first"},
+			expectedErr: "at least two snapshots are required to backfill the changelog",
+		},
+		{
+			name: "snapshot folders",
+			opts: &BackfillOpts{fs: fs, exceptionsPaths: "exemptions.yaml"},
+			args: []string{"first", "second"},
+		},
+		{
+			name: "snapshots dir",
+			opts: &BackfillOpts{fs: fs, exceptionsPaths: "exemptions.yaml", snapshotsPath: "snapshots"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(tc.args)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package changelog
 import (
 	"github.com/mongodb/openapi/tools/cli/internal/cli/changelog/convert"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/changelog/metadata"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/changelog/rules"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +31,15 @@ func Builder() *cobra.Command {
 		CreateBuilder(),
 		metadata.Builder(),
 		convert.Builder(),
+		RenderBuilder(),
+		QueryBuilder(),
+		SplitByTeamBuilder(),
+		LintBuilder(),
+		BackfillBuilder(),
+		DiffBuilder(),
+		ImpactBuilder(),
+		MigrationGuideBuilder(),
+		rules.Builder(),
 	)
 
 	return cmd
@@ -0,0 +1,109 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+)
+
+// Severities of the changes, the notifications show the most severe changes first.
+const (
+	breakingSeverity = iota
+	specCorrectionSeverity
+	backwardCompatibleSeverity
+)
+
+// Change is a changelog change of an operation version, shared by the notification formats.
+type Change struct {
+	Version            string `json:"version"`
+	Method             string `json:"httpMethod"`
+	Path               string `json:"path"`
+	OperationID        string `json:"operationId"`
+	Tag                string `json:"tag"`
+	ChangeType         string `json:"changeType"`
+	Code               string `json:"changeCode"`
+	Description        string `json:"change"`
+	BackwardCompatible bool   `json:"backwardCompatible"`
+	HideFromChangelog  bool   `json:"hideFromChangelog"`
+}
+
+// newChanges returns the changes of the entries ordered by severity.
+// Hidden changes are spec corrections and are only included if includeHidden is true.
+func newChanges(entries []*changelog.Entry, includeHidden bool) []*Change {
+	changes := make([]*Change, 0)
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			for _, version := range path.Versions {
+				for _, change := range version.Changes {
+					if change.HideFromChangelog && !includeHidden {
+						continue
+					}
+
+					changes = append(changes, &Change{
+						Version:            version.Version,
+						Method:             path.HTTPMethod,
+						Path:               path.URI,
+						OperationID:        path.OperationID,
+						Tag:                path.Tag,
+						ChangeType:         version.ChangeType,
+						Code:               change.Code,
+						Description:        change.Description,
+						BackwardCompatible: change.BackwardCompatible,
+						HideFromChangelog:  change.HideFromChangelog,
+					})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(changes, func(a, b *Change) int {
+		return cmp.Compare(a.severity(), b.severity())
+	})
+	return changes
+}
+
+func (c *Change) severity() int {
+	return newSeverity(c.BackwardCompatible, c.HideFromChangelog)
+}
+
+// newSeverity returns the severity of a change.
+// Hidden changes are spec corrections, even if they are not backward compatible.
+func newSeverity(backwardCompatible, hideFromChangelog bool) int {
+	if hideFromChangelog {
+		return specCorrectionSeverity
+	}
+
+	if backwardCompatible {
+		return backwardCompatibleSeverity
+	}
+	return breakingSeverity
+}
+
+// newBatches splits the items into batches of at most batchSize items.
+// A single batch is returned if there are no more than batchSize items, even if there are no items.
+func newBatches[T any](items []T, batchSize int) [][]T {
+	if len(items) <= batchSize {
+		return [][]T{items}
+	}
+
+	batches := make([][]T, 0, (len(items)+batchSize-1)/batchSize)
+	for batch := range slices.Chunk(items, batchSize) {
+		batches = append(batches, batch)
+	}
+	return batches
+}
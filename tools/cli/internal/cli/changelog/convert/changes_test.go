@@ -0,0 +1,78 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEntries() []*changelog.Entry {
+	return []*changelog.Entry{
+		{
+			Date: "2025-03-12",
+			Paths: []*changelog.Path{
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Tag:         "Projects",
+					Versions: []*changelog.Version{
+						{
+							Version:    "2023-01-01",
+							ChangeType: "update",
+							Changes: []*changelog.Change{
+								{Description: "added the optional property 'name'", Code: "response-optional-property-added", BackwardCompatible: true},
+								{Description: "fixed the type of 'id'", Code: "response-property-type-changed", HideFromChangelog: true},
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewChanges(t *testing.T) {
+	changes := newChanges(newTestEntries(), true)
+	require.Len(t, changes, 3)
+	assert.Equal(t, &Change{
+		Version:     "2023-01-01",
+		Method:      "GET",
+		Path:        "/api/atlas/v2/groups",
+		OperationID: "listProjects",
+		Tag:         "Projects",
+		ChangeType:  "update",
+		Code:        "response-property-removed",
+		Description: "removed the property 'id'",
+	}, changes[0])
+	assert.Equal(t, "response-property-type-changed", changes[1].Code)
+	assert.Equal(t, "response-optional-property-added", changes[2].Code)
+
+	changes = newChanges(newTestEntries(), false)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "response-property-removed", changes[0].Code)
+	assert.Equal(t, "response-optional-property-added", changes[1].Code)
+}
+
+func TestNewBatches(t *testing.T) {
+	assert.Equal(t, [][]int{{}}, newBatches([]int{}, 2))
+	assert.Equal(t, [][]int{{1, 2}}, newBatches([]int{1, 2}, 2))
+	assert.Equal(t, [][]int{{1, 2}, {3}}, newBatches([]int{1, 2, 3}, 2))
+}
@@ -24,7 +24,12 @@ func Builder() *cobra.Command {
 		Short: "Convert API Changelog entries into another format.",
 	}
 
-	cmd.AddCommand(SlackBuilder())
+	cmd.AddCommand(
+		SlackBuilder(),
+		TeamsBuilder(),
+		GitHubBuilder(),
+		WebhookBuilder(),
+	)
 
 	return cmd
 }
@@ -0,0 +1,95 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/cobra"
+)
+
+const defaultTitle = "API Changelog"
+
+var severityHeadings = map[int]string{
+	breakingSeverity:           "Breaking Changes",
+	specCorrectionSeverity:     "Spec Corrections",
+	backwardCompatibleSeverity: "Changes",
+}
+
+type GitHubOpts struct {
+	path          string
+	title         string
+	includeHidden bool
+}
+
+func (o *GitHubOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(NewMarkdownSummary(entries, o.title, o.includeHidden))
+	return nil
+}
+
+// NewMarkdownSummary creates a Markdown summary with the changes grouped by severity.
+// It is the body of the GitHub releases and the digest of each owner team.
+func NewMarkdownSummary(entries []*changelog.Entry, title string, includeHidden bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+
+	changes := newChanges(entries, includeHidden)
+	if len(changes) == 0 {
+		b.WriteString("\nNo changes.\n")
+		return b.String()
+	}
+
+	severity := -1
+	for _, change := range changes {
+		if change.severity() != severity {
+			severity = change.severity()
+			fmt.Fprintf(&b, "\n## %s\n\n", severityHeadings[severity])
+		}
+
+		fmt.Fprintf(&b, "- `%s %s` (%s): %s\n", change.Method, change.Path, change.Version, change.Description)
+	}
+
+	return b.String()
+}
+
+// GitHubBuilder constructs the command for converting the changelog entries into the Markdown body of a GitHub release.
+// changelog convert github -p path_to_changelog --title "API Changelog".
+func GitHubBuilder() *cobra.Command {
+	opts := &GitHubOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "github -p path_to_changelog",
+		Short: "Convert the changelog entries into the Markdown body of a GitHub release.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVar(&opts.title, flag.Title, defaultTitle, usage.NotificationTitle)
+	cmd.Flags().BoolVar(&opts.includeHidden, flag.IncludeHidden, false, usage.IncludeHidden)
+	_ = cmd.MarkFlagRequired(flag.Path)
+	return cmd
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMarkdownSummary(t *testing.T) {
+	expected := strings.Join([]string{
+		"# API Changelog",
+		"",
+		"## Breaking Changes",
+		"",
+		"- `GET /api/atlas/v2/groups` (2023-01-01): removed the property 'id'",
+		"",
+		"## Spec Corrections",
+		"",
+		"- `GET /api/atlas/v2/groups` (2023-01-01): fixed the type of 'id'",
+		"",
+		"## Changes",
+		"",
+		"- `GET /api/atlas/v2/groups` (2023-01-01): added the optional property 'name'",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, NewMarkdownSummary(newTestEntries(), "API Changelog", true))
+	assert.NotContains(t, NewMarkdownSummary(newTestEntries(), "API Changelog", false), "Spec Corrections")
+	assert.Equal(t, "# API Changelog\n\nNo changes.\n", NewMarkdownSummary(nil, "API Changelog", false))
+}
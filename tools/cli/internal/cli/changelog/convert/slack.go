@@ -15,9 +15,11 @@
 package convert
 
 import (
+	"cmp"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"sort"
+	"slices"
 	"strconv"
 
 	"github.com/mongodb/openapi/tools/cli/internal/changelog"
@@ -51,9 +53,13 @@ type Attachment struct {
 }
 
 type SlackOpts struct {
-	path      string
-	messageID string
-	channelID string
+	path          string
+	messageID     string
+	channelID     string
+	blocks        bool
+	title         string
+	maxMessages   int
+	changelogLink string
 }
 
 func (o *SlackOpts) Run() error {
@@ -62,7 +68,11 @@ func (o *SlackOpts) Run() error {
 		return err
 	}
 
-	message := o.generateMessage(entries)
+	var message any = o.generateMessage(entries)
+	if o.blocks {
+		message = newBlockMessages(newChanges(entries, true), o.title, o.channelID, o.messageID, o.maxMessages, o.changelogLink)
+	}
+
 	metadataBytes, err := json.MarshalIndent(message, "", "  ")
 	if err != nil {
 		return err
@@ -73,13 +83,10 @@ func (o *SlackOpts) Run() error {
 }
 
 func (o *SlackOpts) generateMessage(entries []*changelog.Entry) []*Message {
-	attachments := make([]*Attachment, 0)
-	for _, entry := range entries {
-		for _, path := range entry.Paths {
-			for _, version := range path.Versions {
-				attachments = append(attachments, newAttachmentFromVersion(path, version)...)
-			}
-		}
+	changes := newChanges(entries, true)
+	attachments := make([]*Attachment, 0, len(changes))
+	for _, change := range changes {
+		attachments = append(attachments, newAttachmentFromChange(change))
 	}
 
 	return newMessagesFromAttachments(orderAttachments(attachments), o.channelID, o.messageID, batchSize)
@@ -88,35 +95,15 @@ func (o *SlackOpts) generateMessage(entries []*changelog.Entry) []*Message {
 // newMessagesFromAttachments creates a slice of messages from the attachments.
 // Slack API has a limit of 100 attachments per message, so we need to split the attachments into multiple messages.
 func newMessagesFromAttachments(attachments []*Attachment, channelID, messageID string, batchSize int) []*Message {
-	numAttachments := len(attachments)
-	if numAttachments <= batchSize {
-		return []*Message{
-			{
-				Channel:     channelID,
-				ThreadTS:    messageID,
-				Parse:       parseFull,
-				Attachments: attachments,
-			},
-		}
-	}
-
-	numBatches := (numAttachments + batchSize - 1) / batchSize
-	messages := make([]*Message, 0)
-	for i := 0; i < numBatches; i++ {
-		start := i * batchSize
-		end := (i + 1) * batchSize
-		if end > numAttachments {
-			end = numAttachments
-		}
-
-		batchAttachments := attachments[start:end]
-		message := &Message{
+	batches := newBatches(attachments, batchSize)
+	messages := make([]*Message, 0, len(batches))
+	for _, batchAttachments := range batches {
+		messages = append(messages, &Message{
 			Channel:     channelID,
 			ThreadTS:    messageID,
 			Parse:       parseFull,
 			Attachments: batchAttachments,
-		}
-		messages = append(messages, message)
+		})
 	}
 
 	return messages
@@ -125,35 +112,15 @@ func newMessagesFromAttachments(attachments []*Attachment, channelID, messageID
 // orderAttachments orders the attachments by backward compatibility.
 // The attachments that are not backward compatible are shown first, then the spec corrections, and finally the backward compatible changes.
 func orderAttachments(attachments []*Attachment) []*Attachment {
-	sort.Slice(attachments, func(i, j int) bool {
-		if attachments[i].Color == attachments[j].Color {
-			return false
-		}
-
-		if attachments[i].Color == notBackwardCompatibleColor {
-			return true
-		}
-		if attachments[i].Color == specCorrectionColor && attachments[j].Color == backwardCompatibleColor {
-			return true
-		}
-
-		return false
+	slices.SortStableFunc(attachments, func(a, b *Attachment) int {
+		return cmp.Compare(colorSeverity[a.Color], colorSeverity[b.Color])
 	})
 	return attachments
 }
 
-func newAttachmentFromVersion(path *changelog.Path, version *changelog.Version) []*Attachment {
-	attachments := make([]*Attachment, 0, len(version.Changes))
-	for _, change := range version.Changes {
-		attachments = append(attachments, newAttachmentFromChange(version.Version, path.HTTPMethod, path.URI, version.ChangeType, change))
-	}
-
-	return attachments
-}
-
-func newAttachmentFromChange(version, method, path, changeType string, change *changelog.Change) *Attachment {
+func newAttachmentFromChange(change *Change) *Attachment {
 	return &Attachment{
-		Text: newAttachmentText(version, method, path, changeType, change.Code, change.Description,
+		Text: newAttachmentText(change.Version, change.Method, change.Path, change.ChangeType, change.Code, change.Description,
 			strconv.FormatBool(change.HideFromChangelog)),
 		Color:          newColorFromBackwardCompatible(change.BackwardCompatible, change.HideFromChangelog),
 		AttachmentType: attachmentTypeDefault,
@@ -166,15 +133,33 @@ func newAttachmentText(version, method, path, changeType, changeCode, change, hi
 		version, hiddenFromChangelog, method, path, changeType, changeCode, change)
 }
 
+var colorSeverity = map[string]int{
+	notBackwardCompatibleColor: breakingSeverity,
+	specCorrectionColor:        specCorrectionSeverity,
+	backwardCompatibleColor:    backwardCompatibleSeverity,
+}
+
 func newColorFromBackwardCompatible(backwardCompatible, hideFromChangelog bool) string {
-	if hideFromChangelog {
+	switch newSeverity(backwardCompatible, hideFromChangelog) {
+	case specCorrectionSeverity:
 		return specCorrectionColor
+	case backwardCompatibleSeverity:
+		return backwardCompatibleColor
+	default:
+		return notBackwardCompatibleColor
 	}
+}
 
-	if backwardCompatible {
-		return backwardCompatibleColor
+func (o *SlackOpts) PreRunE(_ []string) error {
+	if o.maxMessages < 0 {
+		return errors.New("the maximum number of messages must not be negative")
 	}
-	return notBackwardCompatibleColor
+
+	if !o.blocks && (o.maxMessages > 0 || o.changelogLink != "") {
+		return fmt.Errorf("the flags %s and %s require the flag %s", flag.MaxMessages, flag.ChangelogLink, flag.Blocks)
+	}
+
+	return nil
 }
 
 // SlackBuilder constructs the command for converting the changelog entries into a format that can be used with Slack APIs.
@@ -186,7 +171,14 @@ func SlackBuilder() *cobra.Command {
 		Use:     "slack -b path_to_changelo -m message_id -c channel_id",
 		Aliases: []string{"generate"},
 		Short:   "Convert the changelog entries into a format that can be used with Slack APIs.",
-		Args:    cobra.NoArgs,
+		Long: `Convert the changelog entries into a format that can be used with Slack APIs.
+By default, each change is a legacy attachment. With --blocks, the messages use Block Kit: the first message opens
+with a summary of the breaking, non-breaking and hidden changes, followed by the changes grouped by tag and operation.
+Use --max-messages to limit the number of messages, the last message then links to the changelog set with --changelog-link.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
 		RunE: func(_ *cobra.Command, _ []string) error {
 			return opts.Run()
 		},
@@ -195,6 +187,10 @@ func SlackBuilder() *cobra.Command {
 	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
 	cmd.Flags().StringVarP(&opts.channelID, flag.ChannelID, flag.ChannelIDShort, "", usage.SlackChannelID)
 	cmd.Flags().StringVar(&opts.messageID, flag.MessageID, "", usage.MessageID)
+	cmd.Flags().BoolVar(&opts.blocks, flag.Blocks, false, usage.Blocks)
+	cmd.Flags().StringVar(&opts.title, flag.Title, defaultTitle, usage.NotificationTitle)
+	cmd.Flags().IntVar(&opts.maxMessages, flag.MaxMessages, 0, usage.MaxMessages)
+	cmd.Flags().StringVar(&opts.changelogLink, flag.ChangelogLink, "", usage.ChangelogLink)
 	_ = cmd.MarkFlagRequired(flag.Path)
 	_ = cmd.MarkFlagRequired(flag.ChannelID)
 	return cmd
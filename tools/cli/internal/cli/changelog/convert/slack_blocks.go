@@ -0,0 +1,206 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// Slack limits the messages to 50 blocks and the section texts to 3000 characters.
+	maxBlocksPerMessage    = 50
+	maxSectionTextLength   = 3000
+	maxChangesPerOperation = 5
+
+	headerBlockType  = "header"
+	sectionBlockType = "section"
+	contextBlockType = "context"
+	dividerBlockType = "divider"
+	plainTextType    = "plain_text"
+	markdownTextType = "mrkdwn"
+)
+
+var severityEmojis = map[int]string{
+	breakingSeverity:           ":red_circle:",
+	specCorrectionSeverity:     ":large_orange_circle:",
+	backwardCompatibleSeverity: ":large_green_circle:",
+}
+
+// BlockMessage represents a Slack message using Block Kit.
+type BlockMessage struct {
+	Channel  string   `json:"channel"`
+	ThreadTS string   `json:"thread_ts,omitempty"`
+	Text     string   `json:"text"`
+	Blocks   []*Block `json:"blocks"`
+}
+
+// Block is a Block Kit layout block.
+type Block struct {
+	Type     string        `json:"type"`
+	Text     *TextObject   `json:"text,omitempty"`
+	Elements []*TextObject `json:"elements,omitempty"`
+}
+
+// TextObject is a Block Kit text object.
+type TextObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// operationChanges holds the changes of an operation.
+type operationChanges struct {
+	tag         string
+	method      string
+	path        string
+	operationID string
+	changes     []*Change
+}
+
+// newBlockMessages creates the Block Kit messages for the changes.
+// The first message opens with a summary of the changes, followed by the changes grouped by tag and operation.
+// Only the first changes of each operation are listed. If the changes need more than maxMessages messages,
+// the last message links to the full changelog. A maxMessages of 0 means no limit.
+func newBlockMessages(changes []*Change, title, channelID, messageID string, maxMessages int, changelogLink string) []*BlockMessage {
+	summary := newSummaryText(changes)
+	blocks := []*Block{
+		{Type: headerBlockType, Text: &TextObject{Type: plainTextType, Text: title}},
+		newSectionBlock(summary),
+	}
+
+	// blockChanges holds the number of changes of each operation block, to count the changes that are not shown
+	blockChanges := make(map[*Block]int)
+	tag := ""
+	for i, operation := range newOperationChanges(changes) {
+		if i == 0 || operation.tag != tag {
+			tag = operation.tag
+			blocks = append(blocks, &Block{Type: dividerBlockType}, newSectionBlock(fmt.Sprintf("*%s*", tagName(tag))))
+		}
+
+		block := newSectionBlock(newOperationText(operation))
+		blockChanges[block] = len(operation.changes)
+		blocks = append(blocks, block)
+	}
+
+	batches := newBatches(blocks, maxBlocksPerMessage)
+	if maxMessages > 0 && len(batches) > maxMessages {
+		batches = batches[:maxMessages]
+		last := batches[maxMessages-1]
+		// The last block shown is replaced by the overflow block
+		hiddenBlocks := blocks[maxMessages*maxBlocksPerMessage-1:]
+		last[len(last)-1] = newOverflowBlock(hiddenBlocks, blockChanges, changelogLink)
+	}
+
+	messages := make([]*BlockMessage, 0, len(batches))
+	for _, batchBlocks := range batches {
+		messages = append(messages, &BlockMessage{
+			Channel:  channelID,
+			ThreadTS: messageID,
+			Text:     fmt.Sprintf("%s: %s", title, summary),
+			Blocks:   batchBlocks,
+		})
+	}
+
+	return messages
+}
+
+// newSummaryText returns the number of breaking, non-breaking and hidden changes.
+func newSummaryText(changes []*Change) string {
+	counts := make(map[int]int)
+	for _, change := range changes {
+		counts[change.severity()]++
+	}
+
+	return fmt.Sprintf("%s *%d* breaking changes | %s *%d* non-breaking changes | %s *%d* hidden changes",
+		severityEmojis[breakingSeverity], counts[breakingSeverity],
+		severityEmojis[backwardCompatibleSeverity], counts[backwardCompatibleSeverity],
+		severityEmojis[specCorrectionSeverity], counts[specCorrectionSeverity])
+}
+
+// newOperationChanges groups the changes by operation, sorted by tag, path and method.
+// The order of the changes of an operation is preserved, so the most severe changes come first.
+func newOperationChanges(changes []*Change) []*operationChanges {
+	operations := make([]*operationChanges, 0)
+	byKey := make(map[string]*operationChanges)
+	for _, change := range changes {
+		key := strings.Join([]string{change.Tag, change.Method, change.Path}, " ")
+		operation, ok := byKey[key]
+		if !ok {
+			operation = &operationChanges{tag: change.Tag, method: change.Method, path: change.Path, operationID: change.OperationID}
+			byKey[key] = operation
+			operations = append(operations, operation)
+		}
+		operation.changes = append(operation.changes, change)
+	}
+
+	slices.SortFunc(operations, func(a, b *operationChanges) int {
+		return cmp.Or(cmp.Compare(a.tag, b.tag), cmp.Compare(a.path, b.path), cmp.Compare(a.method, b.method))
+	})
+	return operations
+}
+
+// newOperationText lists the first changes of the operation and collapses the others.
+func newOperationText(operation *operationChanges) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "`%s %s`", operation.method, operation.path)
+	if operation.operationID != "" {
+		fmt.Fprintf(&b, " (%s)", operation.operationID)
+	}
+	for i, change := range operation.changes {
+		if i == maxChangesPerOperation {
+			fmt.Fprintf(&b, "\n…and %d more changes", len(operation.changes)-maxChangesPerOperation)
+			break
+		}
+		fmt.Fprintf(&b, "\n%s `%s` %s", severityEmojis[change.severity()], change.Version, change.Description)
+	}
+
+	return truncate(b.String(), maxSectionTextLength)
+}
+
+// newOverflowBlock returns the block replacing the blocks that do not fit in the messages.
+func newOverflowBlock(hiddenBlocks []*Block, blockChanges map[*Block]int, changelogLink string) *Block {
+	hiddenChanges := 0
+	for _, block := range hiddenBlocks {
+		hiddenChanges += blockChanges[block]
+	}
+
+	text := fmt.Sprintf("…%d more changes are not shown.", hiddenChanges)
+	if changelogLink != "" {
+		text += fmt.Sprintf(" See the <%s|full changelog>.", changelogLink)
+	}
+
+	return &Block{Type: contextBlockType, Elements: []*TextObject{{Type: markdownTextType, Text: text}}}
+}
+
+func newSectionBlock(text string) *Block {
+	return &Block{Type: sectionBlockType, Text: &TextObject{Type: markdownTextType, Text: text}}
+}
+
+func tagName(tag string) string {
+	if tag == "" {
+		return "Other"
+	}
+	return tag
+}
+
+func truncate(text string, maxLength int) string {
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	return string(runes[:maxLength-1]) + "…"
+}
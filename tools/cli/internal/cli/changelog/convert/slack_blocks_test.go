@@ -0,0 +1,98 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockMessages(t *testing.T) {
+	messages := newBlockMessages(newChanges(newTestEntries(), true), "API Changelog", "C061EG9SL", "1503435956.000247", 0, "")
+	require.Len(t, messages, 1)
+
+	summary := ":red_circle: *1* breaking changes | :large_green_circle: *1* non-breaking changes | :large_orange_circle: *1* hidden changes"
+	assert.Equal(t, &BlockMessage{
+		Channel:  "C061EG9SL",
+		ThreadTS: "1503435956.000247",
+		Text:     "API Changelog: " + summary,
+		Blocks: []*Block{
+			{Type: headerBlockType, Text: &TextObject{Type: plainTextType, Text: "API Changelog"}},
+			newSectionBlock(summary),
+			{Type: dividerBlockType},
+			newSectionBlock("*Projects*"),
+			newSectionBlock(strings.Join([]string{
+				"`GET /api/atlas/v2/groups` (listProjects)",
+				":red_circle: `2023-01-01` removed the property 'id'",
+				":large_orange_circle: `2023-01-01` fixed the type of 'id'",
+				":large_green_circle: `2023-01-01` added the optional property 'name'",
+			}, "\n")),
+		},
+	}, messages[0])
+}
+
+func newManyChanges(n int) []*Change {
+	changes := make([]*Change, 0, n)
+	for i := range n {
+		changes = append(changes, &Change{
+			Version:            "2024-08-05",
+			Method:             "GET",
+			Path:               fmt.Sprintf("/api/atlas/v2/resources%03d", i),
+			Tag:                "Resources",
+			Description:        "added the optional property 'name'",
+			BackwardCompatible: true,
+		})
+	}
+	return changes
+}
+
+func TestNewBlockMessages_Batches(t *testing.T) {
+	// 2 summary blocks, 2 tag blocks and 100 operation blocks
+	messages := newBlockMessages(newManyChanges(100), "API Changelog", "C061EG9SL", "", 0, "")
+	require.Len(t, messages, 3)
+	assert.Len(t, messages[0].Blocks, maxBlocksPerMessage)
+	assert.Len(t, messages[1].Blocks, maxBlocksPerMessage)
+	assert.Len(t, messages[2].Blocks, 4)
+}
+
+func TestNewBlockMessages_MaxMessages(t *testing.T) {
+	messages := newBlockMessages(newManyChanges(100), "API Changelog", "C061EG9SL", "", 2, "https://example.com/changelog")
+	require.Len(t, messages, 2)
+	require.Len(t, messages[1].Blocks, maxBlocksPerMessage)
+
+	// 95 operations are shown in the first 99 blocks, the last block is replaced by the overflow block
+	assert.Equal(t, &Block{
+		Type: contextBlockType,
+		Elements: []*TextObject{
+			{Type: markdownTextType, Text: "…5 more changes are not shown. See the <https://example.com/changelog|full changelog>."},
+		},
+	}, messages[1].Blocks[maxBlocksPerMessage-1])
+}
+
+func TestNewOperationText_Collapsed(t *testing.T) {
+	operation := &operationChanges{method: "GET", path: "/api/atlas/v2/groups", changes: newManyChanges(maxChangesPerOperation + 2)}
+	text := newOperationText(operation)
+	assert.Equal(t, maxChangesPerOperation+1, strings.Count(text, "\n"))
+	assert.True(t, strings.HasSuffix(text, "\n…and 2 more changes"))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "ab…", truncate("abcd", 3))
+}
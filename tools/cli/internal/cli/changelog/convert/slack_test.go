@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewAttachmentText(t *testing.T) {
@@ -278,3 +279,9 @@ func TestOrderAttachments(t *testing.T) {
 		})
 	}
 }
+
+func TestSlack_PreRun(t *testing.T) {
+	require.NoError(t, (&SlackOpts{blocks: true, maxMessages: 2}).PreRunE(nil))
+	require.EqualError(t, (&SlackOpts{maxMessages: -1}).PreRunE(nil), "the maximum number of messages must not be negative")
+	require.EqualError(t, (&SlackOpts{maxMessages: 2}).PreRunE(nil), "the flags max-messages and changelog-link require the flag blocks")
+}
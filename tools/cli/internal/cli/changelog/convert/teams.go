@@ -0,0 +1,192 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/cobra"
+)
+
+const (
+	teamsMessageType       = "message"
+	adaptiveCardType       = "AdaptiveCard"
+	adaptiveCardSchema     = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion    = "1.4"
+	adaptiveCardAttachment = "application/vnd.microsoft.card.adaptive"
+	// Teams messages are limited to 28 KB, so the cards hold fewer changes than the Slack messages.
+	teamsBatchSize = 25
+
+	attentionStyle = "attention"
+	warningStyle   = "warning"
+	goodStyle      = "good"
+)
+
+// TeamsMessage is a Microsoft Teams message with an Adaptive Card, as accepted by the Teams incoming webhooks.
+type TeamsMessage struct {
+	Type        string             `json:"type"`
+	Attachments []*TeamsAttachment `json:"attachments"`
+}
+
+// TeamsAttachment is the attachment of a Teams message holding an Adaptive Card.
+type TeamsAttachment struct {
+	ContentType string        `json:"contentType"`
+	Content     *AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is a Microsoft Adaptive Card.
+type AdaptiveCard struct {
+	Schema  string          `json:"$schema"`
+	Type    string          `json:"type"`
+	Version string          `json:"version"`
+	Body    []*CardElement  `json:"body"`
+	MSTeams *MSTeamsOptions `json:"msteams,omitempty"`
+}
+
+// MSTeamsOptions are the Teams specific options of an Adaptive Card.
+type MSTeamsOptions struct {
+	Width string `json:"width"`
+}
+
+// CardElement is an element of an Adaptive Card: a TextBlock, a Container or a FactSet.
+type CardElement struct {
+	Type   string         `json:"type"`
+	Text   string         `json:"text,omitempty"`
+	Weight string         `json:"weight,omitempty"`
+	Size   string         `json:"size,omitempty"`
+	Wrap   bool           `json:"wrap,omitempty"`
+	Style  string         `json:"style,omitempty"`
+	Items  []*CardElement `json:"items,omitempty"`
+	Facts  []*Fact        `json:"facts,omitempty"`
+}
+
+// Fact is a title and value pair of a FactSet.
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type TeamsOpts struct {
+	path          string
+	title         string
+	includeHidden bool
+}
+
+func (o *TeamsOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	messageBytes, err := json.MarshalIndent(newTeamsMessages(entries, o.title, o.includeHidden, teamsBatchSize), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(messageBytes))
+	return nil
+}
+
+// newTeamsMessages creates one Teams message with an Adaptive Card for each batch of changes.
+func newTeamsMessages(entries []*changelog.Entry, title string, includeHidden bool, batchSize int) []*TeamsMessage {
+	batches := newBatches(newChanges(entries, includeHidden), batchSize)
+	messages := make([]*TeamsMessage, 0, len(batches))
+	for i, changes := range batches {
+		cardTitle := title
+		if len(batches) > 1 {
+			cardTitle = fmt.Sprintf("%s (%d/%d)", title, i+1, len(batches))
+		}
+
+		body := []*CardElement{{Type: "TextBlock", Text: cardTitle, Weight: "Bolder", Size: "Medium", Wrap: true}}
+		for _, change := range changes {
+			body = append(body, newCardContainer(change))
+		}
+
+		messages = append(messages, &TeamsMessage{
+			Type: teamsMessageType,
+			Attachments: []*TeamsAttachment{
+				{
+					ContentType: adaptiveCardAttachment,
+					Content: &AdaptiveCard{
+						Schema:  adaptiveCardSchema,
+						Type:    adaptiveCardType,
+						Version: adaptiveCardVersion,
+						Body:    body,
+						MSTeams: &MSTeamsOptions{Width: "Full"},
+					},
+				},
+			},
+		})
+	}
+
+	return messages
+}
+
+func newCardContainer(change *Change) *CardElement {
+	return &CardElement{
+		Type:  "Container",
+		Style: newStyleFromSeverity(change.severity()),
+		Items: []*CardElement{
+			{Type: "TextBlock", Text: fmt.Sprintf("**%s %s**", change.Method, change.Path), Wrap: true},
+			{
+				Type: "FactSet",
+				Facts: []*Fact{
+					{Title: "Version", Value: change.Version},
+					{Title: "Change Type", Value: change.ChangeType},
+					{Title: "Change Code", Value: change.Code},
+					{Title: "Hidden from Changelog", Value: strconv.FormatBool(change.HideFromChangelog)},
+				},
+			},
+			{Type: "TextBlock", Text: change.Description, Wrap: true},
+		},
+	}
+}
+
+func newStyleFromSeverity(severity int) string {
+	switch severity {
+	case breakingSeverity:
+		return attentionStyle
+	case specCorrectionSeverity:
+		return warningStyle
+	default:
+		return goodStyle
+	}
+}
+
+// TeamsBuilder constructs the command for converting the changelog entries into Microsoft Teams Adaptive Cards.
+// changelog convert teams -p path_to_changelog --title "API Changelog".
+func TeamsBuilder() *cobra.Command {
+	opts := &TeamsOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "teams -p path_to_changelog",
+		Short: "Convert the changelog entries into Microsoft Teams messages with Adaptive Cards.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVar(&opts.title, flag.Title, defaultTitle, usage.NotificationTitle)
+	cmd.Flags().BoolVar(&opts.includeHidden, flag.IncludeHidden, true, usage.IncludeHidden)
+	_ = cmd.MarkFlagRequired(flag.Path)
+	return cmd
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTeamsMessages(t *testing.T) {
+	messages := newTeamsMessages(newTestEntries(), "API Changelog", true, 2)
+	require.Len(t, messages, 2)
+
+	card := messages[0].Attachments[0].Content
+	assert.Equal(t, adaptiveCardAttachment, messages[0].Attachments[0].ContentType)
+	assert.Equal(t, adaptiveCardType, card.Type)
+	require.Len(t, card.Body, 3)
+	assert.Equal(t, "API Changelog (1/2)", card.Body[0].Text)
+	assert.Equal(t, attentionStyle, card.Body[1].Style)
+	assert.Equal(t, "**GET /api/atlas/v2/groups**", card.Body[1].Items[0].Text)
+	assert.Equal(t, &Fact{Title: "Change Code", Value: "response-property-removed"}, card.Body[1].Items[1].Facts[2])
+	assert.Equal(t, "removed the property 'id'", card.Body[1].Items[2].Text)
+	assert.Equal(t, warningStyle, card.Body[2].Style)
+
+	card = messages[1].Attachments[0].Content
+	require.Len(t, card.Body, 2)
+	assert.Equal(t, "API Changelog (2/2)", card.Body[0].Text)
+	assert.Equal(t, goodStyle, card.Body[1].Style)
+}
+
+func TestNewTeamsMessages_SingleCard(t *testing.T) {
+	messages := newTeamsMessages(newTestEntries(), "API Changelog", false, teamsBatchSize)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "API Changelog", messages[0].Attachments[0].Content.Body[0].Text)
+	assert.Len(t, messages[0].Attachments[0].Content.Body, 3)
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// WebhookData is the data available to the webhook payload template.
+type WebhookData struct {
+	Changes []*Change `json:"changes"`
+	// Batch is the 1-based index of the batch of changes in the payload.
+	Batch   int `json:"batch"`
+	Batches int `json:"batches"`
+}
+
+var webhookFuncs = template.FuncMap{
+	// json encodes a value as JSON, it must be used to include strings in the payload to escape them.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+type WebhookOpts struct {
+	fs            afero.Fs
+	path          string
+	templatePath  string
+	batchSize     int
+	includeHidden bool
+}
+
+func (o *WebhookOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	var tmpl *template.Template
+	if o.templatePath != "" {
+		if tmpl, err = o.newTemplate(); err != nil {
+			return err
+		}
+	}
+
+	payloads, err := newWebhookPayloads(newChanges(entries, o.includeHidden), tmpl, o.batchSize)
+	if err != nil {
+		return err
+	}
+
+	payloadsBytes, err := json.MarshalIndent(payloads, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(payloadsBytes))
+	return nil
+}
+
+func (o *WebhookOpts) newTemplate() (*template.Template, error) {
+	contents, err := afero.ReadFile(o.fs, o.templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New(o.templatePath).Funcs(webhookFuncs).Parse(string(contents))
+}
+
+// newWebhookPayloads creates one JSON payload for each batch of changes.
+// The payload is rendered with the template if provided, otherwise the WebhookData is used as payload.
+func newWebhookPayloads(changes []*Change, tmpl *template.Template, batchSize int) ([]json.RawMessage, error) {
+	batches := newBatches(changes, batchSize)
+	payloads := make([]json.RawMessage, 0, len(batches))
+	for i, batchChanges := range batches {
+		data := &WebhookData{Changes: batchChanges, Batch: i + 1, Batches: len(batches)}
+		if tmpl == nil {
+			payload, err := json.Marshal(data)
+			if err != nil {
+				return nil, err
+			}
+			payloads = append(payloads, payload)
+			continue
+		}
+
+		var b bytes.Buffer
+		if err := tmpl.Execute(&b, data); err != nil {
+			return nil, err
+		}
+
+		if !json.Valid(b.Bytes()) {
+			return nil, fmt.Errorf("the payload of batch %d rendered by the template is not valid JSON", data.Batch)
+		}
+		payloads = append(payloads, b.Bytes())
+	}
+
+	return payloads, nil
+}
+
+func (o *WebhookOpts) PreRunE(_ []string) error {
+	if o.batchSize <= 0 {
+		return errors.New("the batch size must be a positive number")
+	}
+	return nil
+}
+
+// WebhookBuilder constructs the command for converting the changelog entries into generic JSON webhook payloads.
+// changelog convert webhook -p path_to_changelog --template payload.tmpl --batch-size 100.
+func WebhookBuilder() *cobra.Command {
+	opts := &WebhookOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "webhook -p path_to_changelog --template payload.tmpl",
+		Short: "Convert the changelog entries into JSON webhook payloads rendered with a Go template.",
+		Long: `Convert the changelog entries into JSON webhook payloads, one for each batch of changes.
+The payloads are rendered with the Go template provided with --template, which receives the fields
+Changes, Batch and Batches. Each change has the fields Version, Method, Path, OperationID, Tag,
+ChangeType, Code, Description, BackwardCompatible and HideFromChangelog. Use the json function
+to encode values in the payload. Without a template, the fields are encoded as JSON.`,
+		Example: `  # Send the number of changes as text with the template {"text": {{ json (printf "%d changes" (len .Changes)) }}}:
+  foascli changelog convert webhook -p changelog.json --template payload.tmpl`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVar(&opts.templatePath, flag.Template, "", usage.WebhookTemplate)
+	cmd.Flags().IntVar(&opts.batchSize, flag.BatchSize, batchSize, usage.BatchSize)
+	cmd.Flags().BoolVar(&opts.includeHidden, flag.IncludeHidden, false, usage.IncludeHidden)
+	_ = cmd.MarkFlagRequired(flag.Path)
+	return cmd
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhookPayloads(t *testing.T) {
+	changes := newChanges(newTestEntries(), false)
+
+	t.Run("default payload", func(t *testing.T) {
+		payloads, err := newWebhookPayloads(changes, nil, 1)
+		require.NoError(t, err)
+		require.Len(t, payloads, 2)
+
+		var data WebhookData
+		require.NoError(t, json.Unmarshal(payloads[1], &data))
+		assert.Equal(t, 2, data.Batch)
+		assert.Equal(t, 2, data.Batches)
+		assert.Equal(t, []*Change{changes[1]}, data.Changes)
+	})
+
+	t.Run("template payload", func(t *testing.T) {
+		tmpl := template.Must(template.New("payload").Funcs(webhookFuncs).Parse(
+			`{"text": {{ json (printf "%d changes" (len .Changes)) }}, "changes": [{{ range $i, $c := .Changes }}{{ if $i }},{{ end }}{{ json $c.Description }}{{ end }}]}`)) //nolint:lll // Test template
+
+		payloads, err := newWebhookPayloads(changes, tmpl, batchSize)
+		require.NoError(t, err)
+		require.Len(t, payloads, 1)
+		assert.JSONEq(t, `{"text": "2 changes", "changes": ["removed the property 'id'", "added the optional property 'name'"]}`, string(payloads[0]))
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		tmpl := template.Must(template.New("payload").Parse(`{"text": {{ len .Changes }}`))
+
+		_, err := newWebhookPayloads(changes, tmpl, batchSize)
+		require.EqualError(t, err, "the payload of batch 1 rendered by the template is not valid JSON")
+	})
+}
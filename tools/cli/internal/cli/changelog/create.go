@@ -17,9 +17,11 @@ package changelog
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 	"time"
 
 	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
 	"github.com/mongodb/openapi/tools/cli/internal/openapi"
@@ -43,6 +45,10 @@ type Opts struct {
 	outputPath      string
 	dryRun          bool
 	runDate         string
+	ownerTeamsSpec  string
+	includePreviews bool
+	rulesPath       string
+	language        string
 }
 
 func (o *Opts) Run() error {
@@ -55,13 +61,20 @@ func (o *Opts) Run() error {
 		runDate = o.runDate
 	}
 
+	if err := setMessageRules(o.fs, o.rulesPath); err != nil {
+		return err
+	}
+
+	if err := outputfilter.SetLanguage(o.language); err != nil {
+		return err
+	}
+
 	entries, err := changelog.NewEntriesWithRunDate(o.basePath, o.revisionPath, o.exceptionsPaths, runDate)
 	if err != nil {
 		return err
 	}
 
-	notHiddenEntries, err := changelog.NewNotHiddenEntries(entries)
-	if err != nil {
+	if err := setOwnerTeams(entries, o.ownerTeamsSpec); err != nil {
 		return err
 	}
 
@@ -70,32 +83,94 @@ func (o *Opts) Run() error {
 		return err
 	}
 
+	var previews []*changelog.PreviewEntries
+	if o.includePreviews {
+		previews, err = changelog.NewPreviewEntriesWithRunDate(o.basePath, o.revisionPath, o.exceptionsPaths, runDate)
+		if err != nil {
+			return err
+		}
+	}
+
 	if o.dryRun {
 		log.Printf("Detected dry-run mode. No changes will be saved.\n")
 		return nil
 	}
 
-	if err := o.fs.MkdirAll(fmt.Sprintf("%s/%s", o.outputPath, changelogAllFolderName), 0o755); err != nil {
+	if err := saveChangelog(o.fs, o.outputPath, entries, versionedEntries); err != nil {
+		return err
+	}
+
+	return savePreviewChangelogs(o.fs, o.outputPath, previews)
+}
+
+// savePreviewChangelogs saves the changelog of each preview and upcoming version without the hidden changes,
+// in its own file so that the stable changelog is unchanged, e.g. preview/changelog-preview.json.
+func savePreviewChangelogs(fs afero.Fs, outputPath string, previews []*changelog.PreviewEntries) error {
+	for _, preview := range previews {
+		notHiddenEntries, err := changelog.NewNotHiddenEntries(preview.Entries)
+		if err != nil {
+			return err
+		}
+
+		filePath := newOutputFilePath(outputPath, preview.Path())
+		if err := fs.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			return err
+		}
+
+		if err := openapi.SaveToFile(filePath, openapi.JSON, notHiddenEntries, fs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setOwnerTeams sets the owner team of the changelog paths from the x-xgen-owner-team extensions of the spec, if any.
+func setOwnerTeams(entries []*changelog.Entry, specPath string) error {
+	if specPath == "" {
+		return nil
+	}
+
+	spec, err := openapi.NewOpenAPI3().CreateOpenAPISpecFromPath(specPath)
+	if err != nil {
+		return err
+	}
+
+	changelog.SetOwnerTeams(entries, changelog.NewOwnerTeams(spec.Spec))
+	return nil
+}
+
+// saveChangelog saves the changelog files in the output folder:
+//   - changelog.json with the entries without the hidden changes.
+//   - internal/changelog-all.json with all the entries.
+//   - version-diff/<from>_<to>.json with the changes between the versions.
+func saveChangelog(fs afero.Fs, outputPath string, entries, versionedEntries []*changelog.Entry) error {
+	notHiddenEntries, err := changelog.NewNotHiddenEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.MkdirAll(fmt.Sprintf("%s/%s", outputPath, changelogAllFolderName), 0o755); err != nil {
 		return err
 	}
 
-	if errSaveFile := openapi.SaveToFile(o.newOutputFilePath(changelogFileName), "", notHiddenEntries, o.fs); errSaveFile != nil {
+	if errSaveFile := openapi.SaveToFile(newOutputFilePath(outputPath, changelogFileName), "", notHiddenEntries, fs); errSaveFile != nil {
 		return errSaveFile
 	}
 
 	if errSaveFile := openapi.SaveToFile(
-		o.newOutputFilePath(fmt.Sprintf("%s/%s", changelogAllFolderName, changelogAllFileName)), "", entries, o.fs); errSaveFile != nil {
+		newOutputFilePath(outputPath, fmt.Sprintf("%s/%s", changelogAllFolderName, changelogAllFileName)), "", entries, fs); errSaveFile != nil {
 		return errSaveFile
 	}
 
-	if err := o.fs.MkdirAll(fmt.Sprintf("%s/%s", o.outputPath, versionChangelogFolderName), 0o755); err != nil {
+	if err := fs.MkdirAll(fmt.Sprintf("%s/%s", outputPath, versionChangelogFolderName), 0o755); err != nil {
 		return err
 	}
 
 	for _, entry := range versionedEntries {
 		if errSaveFile := openapi.SaveToFile(
-			o.newOutputFilePath(fmt.Sprintf("%s/%s_%s", versionChangelogFolderName, entry.FromVersion, entry.ToVersion)),
-			openapi.JSON, entry.Paths, o.fs); errSaveFile != nil {
+			newOutputFilePath(outputPath, fmt.Sprintf("%s/%s_%s", versionChangelogFolderName, entry.FromVersion, entry.ToVersion)),
+			openapi.JSON, entry.Paths, fs); errSaveFile != nil {
 			return errSaveFile
 		}
 	}
@@ -116,12 +191,18 @@ func (o *Opts) validations() error {
 		return err
 	}
 
+	if o.ownerTeamsSpec != "" {
+		if _, err := o.fs.Stat(o.ownerTeamsSpec); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (o *Opts) newOutputFilePath(fileName string) string {
-	if o.outputPath != "" {
-		return fmt.Sprintf("%s/%s", o.outputPath, fileName)
+func newOutputFilePath(outputPath, fileName string) string {
+	if outputPath != "" {
+		return fmt.Sprintf("%s/%s", outputPath, fileName)
 	}
 
 	return fileName
@@ -152,6 +233,10 @@ func CreateBuilder() *cobra.Command {
 	cmd.Flags().StringVarP(&opts.exceptionsPaths, flag.ExemptionFilePath, flag.ExemptionFilePathShort, "", usage.ExemptionFilePath)
 	cmd.Flags().BoolVarP(&opts.dryRun, flag.DryRun, flag.DryRunShort, false, usage.DryRun)
 	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.ownerTeamsSpec, flag.Spec, flag.SpecShort, "", usage.OwnerTeamsSpec)
+	cmd.Flags().BoolVar(&opts.includePreviews, flag.IncludePreviews, false, usage.IncludePreviews)
+	cmd.Flags().StringVar(&opts.rulesPath, flag.Rules, "", usage.MessageRules)
+	cmd.Flags().StringVar(&opts.language, flag.Language, "", usage.Language)
 	cmd.Flags().StringVar(&opts.runDate, "run-date", "", "Fixed run date for testing (YYYY-MM-DD format)")
 
 	_ = cmd.MarkFlagRequired(flag.Base)
@@ -159,3 +244,19 @@ func CreateBuilder() *cobra.Command {
 
 	return cmd
 }
+
+// setMessageRules loads the message rewriting rules of the changelog from rulesPath, or restores the default rules if rulesPath is empty.
+func setMessageRules(fs afero.Fs, rulesPath string) error {
+	if rulesPath == "" {
+		outputfilter.SetMessageRules(nil)
+		return nil
+	}
+
+	rules, err := outputfilter.NewMessageRulesFromPath(rulesPath, fs)
+	if err != nil {
+		return err
+	}
+
+	outputfilter.SetMessageRules(rules)
+	return nil
+}
@@ -15,8 +15,14 @@
 package changelog
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
 	"github.com/mongodb/openapi/tools/cli/internal/test"
 )
@@ -26,6 +32,50 @@ func TestCreateBuilder(t *testing.T) {
 		t,
 		CreateBuilder(),
 		0,
-		[]string{flag.Output, flag.DryRun, flag.Base, flag.Revision, flag.ExemptionFilePath},
+		[]string{flag.Output, flag.DryRun, flag.Base, flag.Revision, flag.ExemptionFilePath, flag.Spec, flag.IncludePreviews},
 	)
 }
+
+func TestSavePreviewChangelogs(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	previews := []*changelog.PreviewEntries{
+		{
+			Version: "preview",
+			Name:    "preview",
+			Entries: []*changelog.Entry{
+				{
+					Date: "2025-06-12",
+					Paths: []*changelog.Path{
+						{
+							URI:        "/api/atlas/v2/groups",
+							HTTPMethod: "GET",
+							Versions: []*changelog.Version{
+								{Version: "preview", Changes: []*changelog.Change{{Code: "endpoint-added"}}},
+								{Version: "preview", Changes: []*changelog.Change{{Code: "response-property-added", HideFromChangelog: true}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{Version: "private-preview-sandbox", Name: "sandbox", Entries: []*changelog.Entry{}},
+	}
+
+	require.NoError(t, savePreviewChangelogs(fs, "output", previews))
+
+	contents, err := afero.ReadFile(fs, "output/preview/changelog-preview.json")
+	require.NoError(t, err)
+	var entries []*changelog.Entry
+	require.NoError(t, json.Unmarshal(contents, &entries))
+	require.Len(t, entries, 1)
+	require.Len(t, entries[0].Paths[0].Versions, 1)
+	assert.Equal(t, "endpoint-added", entries[0].Paths[0].Versions[0].Changes[0].Code)
+
+	exists, err := afero.Exists(fs, "output/private-preview/changelog-sandbox.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = afero.Exists(fs, "output/changelog.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,342 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/git"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultSpecFolder      = "openapi/v2"
+	defaultChangelogFolder = "changelog"
+	versionsFileName       = "versions.json"
+	shortRevisionLength    = 11
+)
+
+type DiffOpts struct {
+	fs               afero.Fs
+	baseSpecPath     string
+	revisionSpecPath string
+	repoPath         string
+	from             string
+	to               string
+	specFolder       string
+	changelogFolder  string
+	exceptionsPaths  string
+	outputPath       string
+	runDate          string
+	rulesPath        string
+	language         string
+}
+
+func (o *DiffOpts) Run() error {
+	if err := setMessageRules(o.fs, o.rulesPath); err != nil {
+		return err
+	}
+
+	if err := outputfilter.SetLanguage(o.language); err != nil {
+		return err
+	}
+
+	if o.baseSpecPath != "" {
+		paths, err := changelog.NewPathsBetweenSpecs(o.baseSpecPath, o.revisionSpecPath, o.exceptionsPaths)
+		if err != nil {
+			return err
+		}
+		return o.print(paths)
+	}
+
+	entries, err := o.newEntriesFromRevisions()
+	if err != nil {
+		return err
+	}
+	return o.print(entries)
+}
+
+// newEntriesFromRevisions generates the changelog entries between the from and to git revisions.
+func (o *DiffOpts) newEntriesFromRevisions() ([]*changelog.Entry, error) {
+	repo, err := git.Open(o.repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fromCommit, err := repo.ResolveRevision(o.from)
+	if err != nil {
+		return nil, err
+	}
+
+	toCommit, err := repo.ResolveRevision(o.to)
+	if err != nil {
+		return nil, err
+	}
+
+	// the changelog reads the metadata and specs from folders, so the files are extracted from the git objects
+	// into a temporary base and revision folder
+	tmpPath, err := os.MkdirTemp("", "foascli-changelog-diff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	basePath := filepath.Join(tmpPath, "base")
+	if err := o.extractBase(repo, fromCommit, basePath); err != nil {
+		return nil, err
+	}
+
+	revisionPath := filepath.Join(tmpPath, "revision")
+	if err := o.extractRevision(repo, toCommit, revisionPath); err != nil {
+		return nil, err
+	}
+
+	return changelog.NewEntriesWithRunDate(basePath, revisionPath, o.exceptionsPaths, o.runDate)
+}
+
+func (o *DiffOpts) print(data any) error {
+	bytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+// extractBase extracts the specs, the metadata and the changelog of the commit, as the base folder of the create command.
+// The metadata and changelog of the last changelog generation are used when they are in the commit,
+// otherwise the versions of the specs and the commit date are used.
+func (o *DiffOpts) extractBase(repo *git.Repository, commit git.Hash, basePath string) error {
+	if err := extractSpecs(repo, commit, o.specFolder, basePath); err != nil {
+		return err
+	}
+
+	metadata, err := repo.ReadFile(commit, path.Join(o.changelogFolder, changelogAllFolderName, metadataFileName))
+	if errors.Is(err, git.ErrNotFound) {
+		commitTime, timeErr := repo.CommitTime(commit)
+		if timeErr != nil {
+			return timeErr
+		}
+		metadata, err = newMetadataFromVersions(repo, commit, o.specFolder, commitTime.Format(time.DateOnly))
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(basePath, metadataFileName), metadata, 0o600); err != nil {
+		return err
+	}
+
+	entries, err := repo.ReadFile(commit, path.Join(o.changelogFolder, changelogFileName+".json"))
+	if errors.Is(err, git.ErrNotFound) {
+		entries, err = []byte("[]"), nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(basePath, changelogFileName+".json"), entries, 0o600)
+}
+
+// extractRevision extracts the specs of the commit and creates the metadata with their versions, as the revision folder
+// of the create command.
+func (o *DiffOpts) extractRevision(repo *git.Repository, commit git.Hash, revisionPath string) error {
+	if err := extractSpecs(repo, commit, o.specFolder, revisionPath); err != nil {
+		return err
+	}
+
+	metadata, err := newMetadataFromVersions(repo, commit, o.specFolder, o.runDate)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(revisionPath, metadataFileName), metadata, 0o600)
+}
+
+// extractSpecs writes the openapi-<version>.json files of the spec folder of the commit to the output folder.
+func extractSpecs(repo *git.Repository, commit git.Hash, specFolder, outputPath string) error {
+	if err := os.MkdirAll(outputPath, 0o755); err != nil {
+		return err
+	}
+
+	names, err := repo.ReadDir(commit, specFolder)
+	if err != nil {
+		return fmt.Errorf("failed to read the specs of the commit %s: %w", commit, err)
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, "openapi-") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+
+		contents, err := repo.ReadFile(commit, path.Join(specFolder, name))
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(outputPath, name), contents, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newMetadataFromVersions creates the changelog metadata from the versions.json of the spec folder of the commit.
+// The preview version is excluded like in the changelog release workflow.
+func newMetadataFromVersions(repo *git.Repository, commit git.Hash, specFolder, runDate string) ([]byte, error) {
+	contents, err := repo.ReadFile(commit, path.Join(specFolder, versionsFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	if err := json.Unmarshal(contents, &versions); err != nil {
+		return nil, fmt.Errorf("failed to parse %s of the commit %s: %w", versionsFileName, commit, err)
+	}
+
+	stableVersions := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if !apiversion.IsPreviewStabilityLevel(version) {
+			stableVersions = append(stableVersions, version)
+		}
+	}
+
+	return json.Marshal(&changelog.Metadata{
+		RunDate:           runDate,
+		SpecRevision:      commit.String(),
+		SpecRevisionShort: commit.String()[:shortRevisionLength],
+		Versions:          stableVersions,
+	})
+}
+
+func (o *DiffOpts) PreRunE(_ []string) error {
+	if o.baseSpecPath != "" || o.revisionSpecPath != "" {
+		return o.validateSpecs()
+	}
+
+	if o.from == "" {
+		return fmt.Errorf("either --%s or --%s and --%s must be set", flag.From, flag.Base, flag.Revision)
+	}
+
+	if o.exceptionsPaths == "" {
+		return fmt.Errorf("--%s is required with --%s", flag.ExemptionFilePath, flag.From)
+	}
+
+	if o.runDate == "" {
+		o.runDate = time.Now().Format(time.DateOnly)
+	}
+	if _, err := time.Parse(time.DateOnly, o.runDate); err != nil {
+		return fmt.Errorf("invalid run date %q, use the format YYYY-MM-DD", o.runDate)
+	}
+
+	if _, err := o.fs.Stat(o.exceptionsPaths); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSpecs validates the flags of the spec files mode, where the exemptions file is optional.
+func (o *DiffOpts) validateSpecs() error {
+	if o.baseSpecPath == "" || o.revisionSpecPath == "" {
+		return fmt.Errorf("--%s and --%s must be set together", flag.Base, flag.Revision)
+	}
+
+	for _, path := range []string{o.baseSpecPath, o.revisionSpecPath} {
+		if _, err := o.fs.Stat(path); err != nil {
+			return err
+		}
+	}
+
+	if o.exceptionsPaths == "" {
+		return nil
+	}
+
+	_, err := o.fs.Stat(o.exceptionsPaths)
+	return err
+}
+
+// DiffBuilder builds the changelog diff command with the following signatures:
+// changelog diff --repo . --from v2025.10.01 --to HEAD -e exemptions.yaml
+// changelog diff -b old.json -r new.json.
+func DiffBuilder() *cobra.Command {
+	opts := &DiffOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff [--repo path --from revision --to revision -e exemptions.yaml | -b base.json -r revision.json]",
+		Short: "Generate the changelog entries between two git revisions of the OpenAPI spec or between two spec files.",
+		Long: `Generate the changelog entries between two git revisions of the OpenAPI spec, without checking out the revisions.
+The openapi-<version>.json and versions.json files are read from the git objects of the local repository, without network access.
+
+The from revision is the base of the changelog: the changelog.json and internal/metadata.json of the changelog folder are used
+when they exist, otherwise the changelog starts empty at the commit date. The to revision is the revision of the changelog,
+generated at the run date. The entries include the hidden changes, like internal/changelog-all.json of the create command.
+
+The revisions can be branches, tags, commit hashes, followed by ~N or ^N, for example HEAD~1.
+
+With --base and --revision, the changes between two spec files are printed grouped by operation instead, for example to review a PR.
+The same checks, severity levels, message transformations and squashing as the changelog are applied, without writing any file.
+The exemptions file is optional in this mode.`,
+		Example: `  # Preview the changelog of the release branch since the last release:
+  foascli changelog diff --repo . --from v2025.10.01 --to release -e exemptions.yaml
+
+  # Review the changes of a spec file:
+  foascli changelog diff -b old.json -r new.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.baseSpecPath, flag.Base, flag.BaseShort, "", usage.BaseSpec)
+	cmd.Flags().StringVarP(&opts.revisionSpecPath, flag.Revision, flag.RevisionShort, "", usage.RevisionSpec)
+	cmd.Flags().StringVar(&opts.repoPath, flag.Repo, ".", usage.Repo)
+	cmd.Flags().StringVar(&opts.from, flag.From, "", usage.FromRevision)
+	cmd.Flags().StringVar(&opts.to, flag.To, "HEAD", usage.ToRevision)
+	cmd.Flags().StringVar(&opts.specFolder, flag.SpecDir, defaultSpecFolder, usage.SpecDir)
+	cmd.Flags().StringVar(&opts.changelogFolder, flag.ChangelogDir, defaultChangelogFolder, usage.ChangelogDir)
+	cmd.Flags().StringVarP(&opts.exceptionsPaths, flag.ExemptionFilePath, flag.ExemptionFilePathShort, "", usage.ExemptionFilePath)
+	cmd.Flags().StringVar(&opts.rulesPath, flag.Rules, "", usage.MessageRules)
+	cmd.Flags().StringVar(&opts.language, flag.Language, "", usage.Language)
+	cmd.Flags().StringVarP(&opts.runDate, flag.RunDate, flag.RunDateShort, "", usage.RunDate)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+
+	cmd.MarkFlagsMutuallyExclusive(flag.Base, flag.From)
+	return cmd
+}
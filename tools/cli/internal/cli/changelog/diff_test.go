@@ -0,0 +1,239 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepository creates a repository with the base of the new-api-version test data in the first commit, tagged v1,
+// and the revision in the second commit.
+func newTestRepository(t *testing.T, testPath string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repoPath := t.TempDir()
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+
+	copyFile(t, filepath.Join(testPath, "base", "metadata.json"), filepath.Join(repoPath, "changelog", "internal", "metadata.json"))
+	copyFile(t, filepath.Join(testPath, "base", "changelog.json"), filepath.Join(repoPath, "changelog", "changelog.json"))
+	copySpecs(t, filepath.Join(testPath, "base"), repoPath)
+	runGit(t, repoPath, "add", "-A")
+	runGit(t, repoPath, "commit", "-q", "-m", "base")
+	runGit(t, repoPath, "tag", "v1")
+
+	copySpecs(t, filepath.Join(testPath, "revision"), repoPath)
+	runGit(t, repoPath, "add", "-A")
+	runGit(t, repoPath, "commit", "-q", "-m", "revision")
+
+	return repoPath
+}
+
+// copySpecs copies the openapi-<version>.json files to openapi/v2 with their versions.json, including the preview version.
+func copySpecs(t *testing.T, specsPath, repoPath string) {
+	t.Helper()
+	names, err := filepath.Glob(filepath.Join(specsPath, "openapi-*.json"))
+	require.NoError(t, err)
+
+	versions := []string{"preview"}
+	for _, name := range names {
+		copyFile(t, name, filepath.Join(repoPath, "openapi", "v2", filepath.Base(name)))
+		versions = append(versions, strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), "openapi-"), ".json"))
+	}
+
+	contents, err := json.Marshal(versions)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "openapi", "v2", "versions.json"), contents, 0o600))
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+	contents, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(dst), 0o755))
+	require.NoError(t, os.WriteFile(dst, contents, 0o600))
+}
+
+func runGit(t *testing.T, path string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = path
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		"GIT_CONFIG_NOSYSTEM=1", "HOME="+path,
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestDiff_Run(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	fs := afero.NewMemMapFs()
+	opts := &DiffOpts{
+		fs:              fs,
+		repoPath:        newTestRepository(t, testPath),
+		from:            "v1",
+		to:              "HEAD",
+		specFolder:      defaultSpecFolder,
+		changelogFolder: defaultChangelogFolder,
+		exceptionsPaths: testPath + "/exemptions.yaml",
+		outputPath:      "changelog-all.json",
+		runDate:         "2024-08-08",
+	}
+
+	require.NoError(t, opts.Run())
+
+	contents, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	var entries []*changelog.Entry
+	require.NoError(t, json.Unmarshal(contents, &entries))
+
+	expected, err := changelog.NewEntriesWithRunDate(testPath+"/base", testPath+"/revision", opts.exceptionsPaths, opts.runDate)
+	require.NoError(t, err)
+	require.Len(t, entries, len(expected))
+	assert.Equal(t, "2024-08-08", entries[0].Date)
+	assert.Len(t, entries[0].Paths, len(expected[0].Paths))
+}
+
+func TestDiff_RunWithoutChangelog(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	repoPath := newTestRepository(t, testPath)
+	fs := afero.NewMemMapFs()
+	opts := &DiffOpts{
+		fs:              fs,
+		repoPath:        repoPath,
+		from:            "HEAD~1",
+		to:              "main",
+		specFolder:      defaultSpecFolder,
+		changelogFolder: "missing",
+		exceptionsPaths: testPath + "/exemptions.yaml",
+		outputPath:      "changelog-all.json",
+		runDate:         "2024-08-08",
+	}
+
+	require.NoError(t, opts.Run())
+
+	contents, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	var entries []*changelog.Entry
+	require.NoError(t, json.Unmarshal(contents, &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "2024-08-08", entries[0].Date)
+}
+
+func TestDiff_RunUnknownRevision(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	opts := &DiffOpts{
+		fs:              afero.NewMemMapFs(),
+		repoPath:        newTestRepository(t, testPath),
+		from:            "v2",
+		to:              "HEAD",
+		specFolder:      defaultSpecFolder,
+		changelogFolder: defaultChangelogFolder,
+		exceptionsPaths: testPath + "/exemptions.yaml",
+		runDate:         "2024-08-08",
+	}
+
+	require.Error(t, opts.Run())
+}
+
+func TestDiff_PreRun(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "exemptions.yaml", []byte(""), 0o600))
+
+	opts := &DiffOpts{fs: fs, exceptionsPaths: "exemptions.yaml"}
+	require.EqualError(t, opts.PreRunE(nil), "either --from or --base and --revision must be set")
+
+	opts.from = "v1"
+	require.NoError(t, opts.PreRunE(nil))
+	assert.NotEmpty(t, opts.runDate)
+
+	opts.runDate = "08-08-2024"
+	require.EqualError(t, opts.PreRunE(nil), `invalid run date "08-08-2024", use the format YYYY-MM-DD`)
+
+	opts.runDate = "2024-08-08"
+	opts.exceptionsPaths = "missing.yaml"
+	require.Error(t, opts.PreRunE(nil))
+
+	opts.exceptionsPaths = ""
+	require.EqualError(t, opts.PreRunE(nil), "--exemption-file is required with --from")
+}
+
+func TestDiff_PreRunSpecs(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "old.json", []byte("{}"), 0o600))
+	require.NoError(t, afero.WriteFile(fs, "new.json", []byte("{}"), 0o600))
+
+	opts := &DiffOpts{fs: fs, baseSpecPath: "old.json"}
+	require.EqualError(t, opts.PreRunE(nil), "--base and --revision must be set together")
+
+	opts.revisionSpecPath = "new.json"
+	require.NoError(t, opts.PreRunE(nil))
+
+	opts.exceptionsPaths = "missing.yaml"
+	require.Error(t, opts.PreRunE(nil))
+
+	opts.exceptionsPaths = ""
+	opts.revisionSpecPath = "missing.json"
+	require.Error(t, opts.PreRunE(nil))
+}
+
+func TestDiff_RunSpecs(t *testing.T) {
+	testPath := "../../../test/data/changelog/new-api-version"
+	fs := afero.NewMemMapFs()
+	opts := &DiffOpts{
+		fs:               fs,
+		baseSpecPath:     testPath + "/base/openapi-2024-05-30.json",
+		revisionSpecPath: testPath + "/revision/openapi-2024-08-05.json",
+		outputPath:       "changes.json",
+	}
+
+	require.NoError(t, opts.Run())
+
+	contents, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	var paths []*changelog.Path
+	require.NoError(t, json.Unmarshal(contents, &paths))
+	require.NotEmpty(t, paths)
+	assert.Equal(t, "listClusters", paths[0].OperationID)
+	assert.NotEmpty(t, paths[0].Changes)
+}
+
+func TestDiffBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		DiffBuilder(),
+		0,
+		[]string{
+			flag.Base, flag.Revision, flag.Repo, flag.From, flag.To, flag.SpecDir, flag.ChangelogDir,
+			flag.ExemptionFilePath, flag.RunDate, flag.Output,
+		},
+	)
+}
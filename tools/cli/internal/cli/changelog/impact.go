@@ -0,0 +1,208 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var impactColumns = []string{"Date", "Kind", "Method", "Path", "Operation ID", "Tag", "Version", "Change Code", "Change"}
+
+type ImpactOpts struct {
+	fs           afero.Fs
+	path         string
+	revisionPath string
+	opsPath      string
+	version      string
+	since        string
+	outputPath   string
+	format       string
+}
+
+func (o *ImpactOpts) Run() error {
+	operationIDs, err := o.readOperationIDs()
+	if err != nil {
+		return err
+	}
+
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	spec, err := openapi.NewOpenAPI3().CreateOpenAPISpecFromPath(o.specPath())
+	if err != nil {
+		return err
+	}
+
+	report := changelog.NewImpactReport(entries, spec.Spec, o.version, operationIDs, o.since)
+	bytes, err := impactReportAsBytes(report, o.format)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+func (o *ImpactOpts) specPath() string {
+	return fmt.Sprintf("%s/openapi-%s.json", o.revisionPath, o.version)
+}
+
+// readOperationIDs reads the operation IDs of the ops file, one per line. Empty lines and lines starting with # are skipped.
+func (o *ImpactOpts) readOperationIDs() ([]string, error) {
+	contents, err := afero.ReadFile(o.fs, o.opsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var operationIDs []string
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		operationIDs = append(operationIDs, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(operationIDs) == 0 {
+		return nil, fmt.Errorf("no operation IDs found in %s", o.opsPath)
+	}
+
+	return operationIDs, nil
+}
+
+func impactReportAsBytes(report *changelog.ImpactReport, format string) ([]byte, error) {
+	if format == jsonFormat {
+		return json.MarshalIndent(report, "", "  ")
+	}
+
+	rows := make([][]string, 0, len(report.Impacts))
+	for _, impact := range report.Impacts {
+		rows = append(rows, []string{
+			impact.Date,
+			impact.Kind,
+			impact.HTTPMethod,
+			impact.Path,
+			impact.OperationID,
+			impact.Tag,
+			impact.Version,
+			impact.ChangeCode,
+			impact.Change,
+		})
+	}
+
+	var out []byte
+	if format == tableFormat {
+		table, err := queryResultAsTable(impactColumns, rows)
+		if err != nil {
+			return nil, err
+		}
+		out = table
+	} else {
+		out = queryResultAsMarkdown(impactColumns, rows)
+	}
+
+	if len(report.UnavailableOperationIDs) > 0 {
+		out = fmt.Appendf(out, "\n\nOperations not available in the version %s: %s",
+			report.Version, strings.Join(report.UnavailableOperationIDs, ", "))
+	}
+
+	return out, nil
+}
+
+func (o *ImpactOpts) PreRunE(_ []string) error {
+	if o.since != "" {
+		if _, err := time.Parse(time.DateOnly, o.since); err != nil {
+			return fmt.Errorf("invalid date %q, use the format YYYY-MM-DD", o.since)
+		}
+	}
+
+	if o.format != jsonFormat && o.format != tableFormat && o.format != markdownFormat {
+		return fmt.Errorf("format must be either 'json', 'table' or 'markdown', got '%s'", o.format)
+	}
+
+	for _, path := range []string{o.path, o.opsPath, o.specPath()} {
+		if _, err := o.fs.Stat(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImpactBuilder builds the changelog impact command with the following signature:
+// changelog impact -p changelog.json -r revision_folder --ops ops.txt --version 2024-08-05 --since 2025-01-01.
+func ImpactBuilder() *cobra.Command {
+	opts := &ImpactOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "impact -p path_to_changelog -r path_folder --ops ops.txt --version version",
+		Short: "List the changes affecting the operations of a client pinned to an API version.",
+		Long: `List the changes affecting the operations of a client pinned to an API version, with their dates:
+  - breaking: the breaking changes of the resource version served for the operation.
+  - deprecation: the deprecations of the resource version served for the operation.
+  - sunset: the date when the resource version served for the operation is removed.
+
+The ops file lists the operation IDs called by the client, one per line. The resource version served for each operation
+is read from the openapi-<version>.json spec of the revision folder. The hidden changes are skipped.`,
+		Example: `  # Changes affecting a client pinned to 2024-08-05 since January 2025:
+  foascli changelog impact -p changelog/changelog.json -r openapi/v2 --ops ops.txt --version 2024-08-05 --since 2025-01-01 -f table`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVarP(&opts.revisionPath, flag.Revision, flag.RevisionShort, "", usage.RevisionFolder)
+	cmd.Flags().StringVar(&opts.opsPath, flag.Ops, "", usage.Ops)
+	cmd.Flags().StringVar(&opts.version, flag.Version, "", usage.PinnedVersion)
+	cmd.Flags().StringVar(&opts.since, flag.Since, "", usage.Since)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, jsonFormat, usage.QueryFormat)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	_ = cmd.MarkFlagRequired(flag.Revision)
+	_ = cmd.MarkFlagRequired(flag.Ops)
+	_ = cmd.MarkFlagRequired(flag.Version)
+	return cmd
+}
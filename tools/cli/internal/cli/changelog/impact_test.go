@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newImpactOpts(t *testing.T, format string) (*ImpactOpts, afero.Fs) {
+	t.Helper()
+	testPath := "../../../test/data/changelog/new-api-version"
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "ops.txt", []byte("# client operations\nlistClusters\n\nremovedOperation\n"), 0o600))
+
+	return &ImpactOpts{
+		fs:           afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(afero.NewOsFs()), fs),
+		path:         testPath + "/output/changelog.json",
+		revisionPath: testPath + "/revision",
+		opsPath:      "ops.txt",
+		version:      "2024-08-05",
+		since:        "2024-08-01",
+		outputPath:   "impact",
+		format:       format,
+	}, fs
+}
+
+func TestImpact_Run(t *testing.T) {
+	opts, fs := newImpactOpts(t, jsonFormat)
+	require.NoError(t, opts.PreRunE(nil))
+	require.NoError(t, opts.Run())
+
+	contents, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	var report changelog.ImpactReport
+	require.NoError(t, json.Unmarshal(contents, &report))
+
+	assert.Equal(t, "2024-08-05", report.Version)
+	assert.Equal(t, []string{"removedOperation"}, report.UnavailableOperationIDs)
+	require.NotEmpty(t, report.Impacts)
+	for _, impact := range report.Impacts {
+		assert.Equal(t, "listClusters", impact.OperationID)
+		assert.Equal(t, "2024-08-05", impact.Version)
+		assert.Equal(t, changelog.ImpactBreaking, impact.Kind)
+		assert.GreaterOrEqual(t, impact.Date, opts.since)
+	}
+}
+
+func TestImpact_RunTable(t *testing.T) {
+	opts, fs := newImpactOpts(t, tableFormat)
+	require.NoError(t, opts.Run())
+
+	contents, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "DATE        KIND")
+	assert.Contains(t, string(contents), "response-optional-property-removed")
+	assert.Contains(t, string(contents), "Operations not available in the version 2024-08-05: removedOperation")
+}
+
+func TestImpact_ReadOperationIDs(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "empty.txt", []byte("# no operations\n\n"), 0o600))
+
+	opts := &ImpactOpts{fs: fs, opsPath: "empty.txt"}
+	_, err := opts.readOperationIDs()
+	require.EqualError(t, err, "no operation IDs found in empty.txt")
+}
+
+func TestImpact_PreRun(t *testing.T) {
+	opts, _ := newImpactOpts(t, "csv")
+	require.EqualError(t, opts.PreRunE(nil), "format must be either 'json', 'table' or 'markdown', got 'csv'")
+
+	opts.format = jsonFormat
+	opts.since = "01-01-2025"
+	require.EqualError(t, opts.PreRunE(nil), `invalid date "01-01-2025", use the format YYYY-MM-DD`)
+
+	opts.since = ""
+	opts.version = "2020-01-01"
+	require.Error(t, opts.PreRunE(nil))
+}
+
+func TestImpactBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ImpactBuilder(),
+		0,
+		[]string{flag.Path, flag.Revision, flag.Ops, flag.Version, flag.Since, flag.Format, flag.Output},
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type LintOpts struct {
+	fs           afero.Fs
+	path         string
+	metadataPath string
+	specPath     string
+	outputPath   string
+	format       string
+}
+
+func (o *LintOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	contents, err := afero.ReadFile(o.fs, o.metadataPath)
+	if err != nil {
+		return err
+	}
+
+	var metadata *changelog.Metadata
+	if err := json.Unmarshal(contents, &metadata); err != nil {
+		return err
+	}
+
+	specInfo, err := openapi.NewOpenAPI3().CreateOpenAPISpecFromPath(o.specPath)
+	if err != nil {
+		return err
+	}
+
+	violations := changelog.Lint(entries, metadata, specInfo.Spec)
+	if violations == nil {
+		violations = []*changelog.Violation{}
+	}
+
+	bytes, err := lintViolationsAsBytes(violations, o.format)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		err = afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	} else {
+		fmt.Println(string(bytes))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("found %d changelog integrity violations", len(violations))
+	}
+	return nil
+}
+
+func lintViolationsAsBytes(violations []*changelog.Violation, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(violations, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ToLower(format) == openapi.JSON {
+		return data, nil
+	}
+
+	var jsonData any
+	if mErr := json.Unmarshal(data, &jsonData); mErr != nil {
+		return nil, mErr
+	}
+
+	return yaml.Marshal(jsonData)
+}
+
+func (o *LintOpts) PreRunE(_ []string) error {
+	if format := strings.ToLower(o.format); format != openapi.JSON && format != openapi.YAML {
+		return fmt.Errorf("format must be either 'json' or 'yaml', got '%s'", o.format)
+	}
+
+	return nil
+}
+
+// LintBuilder builds the changelog lint command with the following signature:
+// changelog lint -p changelog.json -m metadata.json -s openapi.json.
+func LintBuilder() *cobra.Command {
+	opts := &LintOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint -p path_to_changelog -m path_to_metadata -s spec.json",
+		Short: "Verify the integrity of a changelog file.",
+		Long: `Verify the integrity of a changelog file, usually after a manual correction:
+  - invalid-date: the date of an entry is not in the format YYYY-MM-DD.
+  - unsorted-dates: the entries are not sorted by date in descending order.
+  - duplicate-date: two entries have the same date.
+  - duplicate-change: a change is reported twice for the same path, method and version of an entry.
+  - unknown-version: a version is not in the versions of the metadata file.
+  - unknown-operation: an operation is not in the spec and has no endpoint-removed change.
+
+Violations are reported with the JSON pointer of the offending value. The command fails if any violation is found.`,
+		Example: `  # Lint the changelog against the current spec:
+  foascli changelog lint -p changelog/changelog.json -m changelog/internal/metadata.json -s openapi/.raw/v2.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVarP(&opts.metadataPath, flag.Metadata, flag.MetadataShort, "", usage.ChangelogMetadata)
+	cmd.Flags().StringVarP(&opts.specPath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, openapi.JSON, usage.Format)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	_ = cmd.MarkFlagRequired(flag.Metadata)
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	return cmd
+}
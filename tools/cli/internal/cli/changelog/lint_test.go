@@ -0,0 +1,54 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &LintOpts{
+		fs:           afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(afero.NewOsFs()), fs),
+		path:         "../../../test/data/changelog/new-api-version/output/changelog-all.json",
+		metadataPath: "../../../test/data/changelog/new-api-version/revision/metadata.json",
+		specPath:     "../../../test/data/changelog/new-api-version/revision/openapi-2024-08-05.json",
+		outputPath:   "violations.json",
+		format:       openapi.JSON,
+	}
+
+	require.EqualError(t, opts.Run(), "found 1 changelog integrity violations")
+
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+
+	var violations []*changelog.Violation
+	require.NoError(t, json.Unmarshal(b, &violations))
+	require.Len(t, violations, 1)
+	assert.Equal(t, changelog.DuplicateChangeRule, violations[0].Rule)
+	assert.Equal(t, "/2/paths/1/versions/0/changes/1", violations[0].Pointer)
+}
+
+func TestLint_PreRun(t *testing.T) {
+	require.NoError(t, (&LintOpts{format: "YAML"}).PreRunE(nil))
+	require.EqualError(t, (&LintOpts{format: "table"}).PreRunE(nil), "format must be either 'json' or 'yaml', got 'table'")
+}
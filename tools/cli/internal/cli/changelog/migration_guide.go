@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/render"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type MigrationGuideOpts struct {
+	fs          afero.Fs
+	path        string
+	fromVersion string
+	toVersion   string
+	outputPath  string
+}
+
+func (o *MigrationGuideOpts) Run() error {
+	contents, err := afero.ReadFile(o.fs, o.versionDiffPath())
+	if err != nil {
+		return err
+	}
+
+	var paths []*changelog.Path
+	if err := json.Unmarshal(contents, &paths); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", o.versionDiffPath(), err)
+	}
+
+	guide := render.NewMigrationGuide(paths, o.fromVersion, o.toVersion)
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, []byte(guide), 0o600)
+	}
+
+	fmt.Println(guide)
+	return nil
+}
+
+// versionDiffPath returns the path of the version diff file generated by changelog create, i.e. version-diff/<from>_<to>.json.
+func (o *MigrationGuideOpts) versionDiffPath() string {
+	return fmt.Sprintf("%s/%s_%s.json", o.path, o.fromVersion, o.toVersion)
+}
+
+func (o *MigrationGuideOpts) PreRunE(_ []string) error {
+	if o.fromVersion >= o.toVersion {
+		return fmt.Errorf("the version %s must be older than the version %s", o.fromVersion, o.toVersion)
+	}
+
+	if _, err := o.fs.Stat(o.versionDiffPath()); err != nil {
+		return fmt.Errorf("no version diff between %s and %s: %w", o.fromVersion, o.toVersion, err)
+	}
+
+	return nil
+}
+
+// MigrationGuideBuilder builds the changelog migration-guide command with the following signature:
+// changelog migration-guide -p version-diff --from 2023-02-01 --to 2025-03-12.
+func MigrationGuideBuilder() *cobra.Command {
+	opts := &MigrationGuideOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migration-guide -p path_to_version_diff_folder --from version --to version",
+		Short: "Generate a Markdown migration guide between two API versions.",
+		Long: `Generate a Markdown migration guide between two API versions from the version-diff/<from>_<to>.json file
+generated by the changelog create command. The changes are grouped by tag and operation, split into request, response
+and operation sections, and list the removed fields, newly required fields and parameters, enum values and added fields.
+The changes that are not backward compatible are highlighted.`,
+		Example: `  # Migration guide from 2023-02-01 to 2025-03-12:
+  foascli changelog migration-guide -p changelog/version-diff --from 2023-02-01 --to 2025-03-12 -o migration.md`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.VersionDiffFolder)
+	cmd.Flags().StringVar(&opts.fromVersion, flag.From, "", usage.MigrationFrom)
+	cmd.Flags().StringVar(&opts.toVersion, flag.To, "", usage.MigrationTo)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	_ = cmd.MarkFlagRequired(flag.From)
+	_ = cmd.MarkFlagRequired(flag.To)
+	return cmd
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationGuide_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &MigrationGuideOpts{
+		fs:          afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(afero.NewOsFs()), fs),
+		path:        "../../../test/data/changelog/new-api-version/output",
+		fromVersion: "2024-05-30",
+		toVersion:   "2024-08-05",
+		outputPath:  "migration.md",
+	}
+
+	require.NoError(t, opts.PreRunE(nil))
+	require.NoError(t, opts.Run())
+
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "# Migration Guide from 2024-05-30 to 2024-08-05")
+	assert.Contains(t, string(b), "## Clusters")
+	assert.Contains(t, string(b), "### `POST /api/atlas/v2/groups/{groupId}/clusters` (createCluster)")
+	assert.Contains(t, string(b), "#### Request")
+}
+
+func TestMigrationGuide_PreRun(t *testing.T) {
+	opts := &MigrationGuideOpts{
+		fs:          afero.NewOsFs(),
+		path:        "../../../test/data/changelog/new-api-version/output",
+		fromVersion: "2024-08-05",
+		toVersion:   "2024-05-30",
+	}
+	require.EqualError(t, opts.PreRunE(nil), "the version 2024-08-05 must be older than the version 2024-05-30")
+
+	opts.fromVersion, opts.toVersion = "2023-01-01", "2025-01-01"
+	require.ErrorContains(t, opts.PreRunE(nil), "no version diff between 2023-01-01 and 2025-01-01")
+}
+
+func TestMigrationGuideBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		MigrationGuideBuilder(),
+		0,
+		[]string{flag.Path, flag.From, flag.To, flag.Output},
+	)
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jsonFormat     = "json"
+	tableFormat    = "table"
+	markdownFormat = "markdown"
+)
+
+var queryColumns = []string{"Date", "Version", "Method", "Path", "Operation ID", "Tag", "Change Code", "Breaking", "Hidden", "Change"}
+
+type QueryOpts struct {
+	fs         afero.Fs
+	path       string
+	outputPath string
+	format     string
+	query      changelog.Query
+}
+
+func (o *QueryOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := queryResultAsBytes(o.query.Apply(entries), o.format)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+func queryResultAsBytes(entries []*changelog.Entry, format string) ([]byte, error) {
+	switch format {
+	case tableFormat:
+		return queryResultAsTable(queryColumns, queryRows(entries))
+	case markdownFormat:
+		return queryResultAsMarkdown(queryColumns, queryRows(entries)), nil
+	default:
+		return json.MarshalIndent(entries, "", "  ")
+	}
+}
+
+// queryRows flattens the entries to one row per change with the queryColumns.
+func queryRows(entries []*changelog.Entry) [][]string {
+	var rows [][]string
+	addRows := func(entry *changelog.Entry, path *changelog.Path, version string, changes []*changelog.Change) {
+		for _, change := range changes {
+			rows = append(rows, []string{
+				entry.Date,
+				version,
+				path.HTTPMethod,
+				path.URI,
+				path.OperationID,
+				path.Tag,
+				change.Code,
+				strconv.FormatBool(!change.BackwardCompatible),
+				strconv.FormatBool(change.HideFromChangelog),
+				change.Description,
+			})
+		}
+	}
+
+	for _, entry := range entries {
+		for _, path := range entry.Paths {
+			for _, version := range path.Versions {
+				addRows(entry, path, version.Version, version.Changes)
+			}
+			addRows(entry, path, entry.ToVersion, path.Changes)
+		}
+	}
+
+	return rows
+}
+
+func queryResultAsTable(columns []string, rows [][]string) ([]byte, error) {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+func queryResultAsMarkdown(columns []string, rows [][]string) []byte {
+	var b strings.Builder
+	b.WriteString("| " + strings.Join(columns, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(columns)))
+	for _, row := range rows {
+		cells := make([]string, 0, len(row))
+		for _, cell := range row {
+			cells = append(cells, strings.ReplaceAll(cell, "|", `\|`))
+		}
+		b.WriteString("\n| " + strings.Join(cells, " | ") + " |")
+	}
+
+	return []byte(b.String())
+}
+
+func (o *QueryOpts) PreRunE(_ []string) error {
+	for _, date := range []string{o.query.From, o.query.To} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return fmt.Errorf("invalid date %q, use the format YYYY-MM-DD", date)
+		}
+	}
+
+	if o.format != jsonFormat && o.format != tableFormat && o.format != markdownFormat {
+		return fmt.Errorf("format must be either 'json', 'table' or 'markdown', got '%s'", o.format)
+	}
+
+	return nil
+}
+
+// QueryBuilder builds the changelog query command with the following signature:
+// changelog query -p changelog.json --from 2025-03-01 --path-prefix /api/atlas/v2/groups/{groupId}/clusters --backward-compatible=false.
+func QueryBuilder() *cobra.Command {
+	opts := &QueryOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	var backwardCompatible, hidden bool
+	cmd := &cobra.Command{
+		Use:   "query -p path_to_changelog",
+		Short: "Query the changes of a changelog file.",
+		Long: `Query the changes of a changelog file. The filters are combined, and the filters accepting a list
+match any of the values. The results are printed as JSON entries, a table or a Markdown table.`,
+		Example: `  # Breaking changes of the cluster endpoints since March 2025:
+  foascli changelog query -p changelog.json --from 2025-03-01 --path-prefix /api/atlas/v2/groups/{groupId}/clusters \
+    --backward-compatible=false -f table`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(flag.BackwardCompatible) {
+				opts.query.BackwardCompatible = &backwardCompatible
+			}
+			if cmd.Flags().Changed(flag.Hidden) {
+				opts.query.Hidden = &hidden
+			}
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVar(&opts.query.From, flag.From, "", usage.From)
+	cmd.Flags().StringVar(&opts.query.To, flag.To, "", usage.To)
+	cmd.Flags().StringSliceVar(&opts.query.OperationIDs, flag.OperationIDs, nil, usage.QueryOperationIDs)
+	cmd.Flags().StringSliceVar(&opts.query.Tags, flag.Tags, nil, usage.QueryTags)
+	cmd.Flags().StringVar(&opts.query.PathPrefix, flag.PathPrefix, "", usage.PathPrefix)
+	cmd.Flags().StringSliceVarP(&opts.query.Versions, flag.Versions, flag.VersionsShort, nil, usage.QueryVersions)
+	cmd.Flags().StringSliceVar(&opts.query.ChangeCodes, flag.ChangeCodes, nil, usage.ChangeCodes)
+	cmd.Flags().BoolVar(&backwardCompatible, flag.BackwardCompatible, false, usage.BackwardCompatible)
+	cmd.Flags().BoolVar(&hidden, flag.Hidden, false, usage.Hidden)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, jsonFormat, usage.QueryFormat)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	return cmd
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Run(t *testing.T) {
+	breaking := false
+	fs := afero.NewMemMapFs()
+	opts := &QueryOpts{
+		fs:         fs,
+		path:       "../../../test/data/changelog/changelog.json",
+		outputPath: "query.json",
+		format:     jsonFormat,
+		query: changelog.Query{
+			PathPrefix:         "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/globalWrites",
+			BackwardCompatible: &breaking,
+		},
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+
+	var entries []*changelog.Entry
+	require.NoError(t, json.Unmarshal(b, &entries))
+	require.Len(t, entries, 1)
+	require.Len(t, entries[0].Paths, 1)
+	assert.Equal(t, "createCustomZoneMapping", entries[0].Paths[0].OperationID)
+}
+
+func TestQueryResultAsBytes(t *testing.T) {
+	entries := []*changelog.Entry{
+		{
+			Date: "2025-03-12",
+			Paths: []*changelog.Path{
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Tag:         "Projects",
+					Versions: []*changelog.Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*changelog.Change{{Description: "changed 'a|b'", Code: "response-property-removed"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	table, err := queryResultAsBytes(entries, tableFormat)
+	require.NoError(t, err)
+	lines := strings.Split(string(table), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "DATE        VERSION     METHOD  PATH"))
+	assert.True(t, strings.HasPrefix(lines[1], "2025-03-12  2023-01-01  GET     /api/atlas/v2/groups"))
+
+	markdown, err := queryResultAsBytes(entries, markdownFormat)
+	require.NoError(t, err)
+	expected := strings.Join([]string{
+		"| Date | Version | Method | Path | Operation ID | Tag | Change Code | Breaking | Hidden | Change |",
+		"| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |",
+		`| 2025-03-12 | 2023-01-01 | GET | /api/atlas/v2/groups | listProjects | Projects | response-property-removed | true | false | changed 'a\|b' |`,
+	}, "\n")
+	assert.Equal(t, expected, string(markdown))
+}
+
+func TestQuery_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *QueryOpts
+		expectedErr string
+	}{
+		{
+			name:        "invalid date",
+			opts:        &QueryOpts{format: jsonFormat, query: changelog.Query{From: "March"}},
+			expectedErr: `invalid date "March", use the format YYYY-MM-DD`,
+		},
+		{
+			name:        "invalid format",
+			opts:        &QueryOpts{format: "csv"},
+			expectedErr: "format must be either 'json', 'table' or 'markdown', got 'csv'",
+		},
+		{
+			name: "valid",
+			opts: &QueryOpts{format: tableFormat, query: changelog.Query{From: "2025-03-01", To: "2025-03-31"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/render"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const defaultFeedTitle = "API Changelog"
+
+type RenderOpts struct {
+	fs         afero.Fs
+	path       string
+	outputPath string
+	format     string
+	feedTitle  string
+	feedLink   string
+}
+
+func (o *RenderOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := render.Render(entries, o.format, &render.FeedConfig{
+		Title: o.feedTitle,
+		Link:  o.feedLink,
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+func (o *RenderOpts) PreRunE(_ []string) error {
+	o.format = strings.ToLower(o.format)
+	if !slices.Contains(render.Formats, o.format) {
+		return fmt.Errorf("format must be one of %s, got '%s'", strings.Join(render.Formats, ", "), o.format)
+	}
+
+	if (o.format == render.Atom || o.format == render.RSS) && o.feedLink == "" {
+		return fmt.Errorf("the flag %s is required for the %s format", flag.FeedLink, o.format)
+	}
+
+	return nil
+}
+
+// RenderBuilder builds the changelog render command with the following signature:
+// changelog render -p changelog.json -f markdown -o changelog.md.
+func RenderBuilder() *cobra.Command {
+	opts := &RenderOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render -p path_to_changelog -f markdown|html|atom|rss",
+		Short: "Render the changelog entries as Markdown, HTML or an Atom or RSS feed.",
+		Long: `Render the changelog entries as Markdown, HTML or an Atom or RSS feed.
+The changes are grouped by date, tag and version, and the changes that are not backward compatible are highlighted.
+The feeds include one item per date, linked to the date anchor of the changelog page provided with --feed-link.`,
+		Example: `  # Render the changelog as Markdown:
+  foascli changelog render -p changelog.json -o changelog.md
+
+  # Render the changelog as an Atom feed:
+  foascli changelog render -p changelog.json -f atom --feed-link https://www.mongodb.com/docs/api/doc/atlas-admin-api-v2/changelog -o changelog.xml`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, render.Markdown, usage.RenderFormat)
+	cmd.Flags().StringVar(&opts.feedTitle, flag.FeedTitle, defaultFeedTitle, usage.FeedTitle)
+	cmd.Flags().StringVar(&opts.feedLink, flag.FeedLink, "", usage.FeedLink)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	return cmd
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_Run(t *testing.T) {
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{format: "markdown", expected: "## 2023-02-01"},
+		{format: "html", expected: `<section class="changelog-date" id="2023-02-01">`},
+		{format: "atom", expected: "<id>https://example.com/changelog#2023-02-01</id>"},
+		{format: "rss", expected: "<pubDate>Wed, 01 Feb 2023 00:00:00 +0000</pubDate>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			opts := &RenderOpts{
+				fs:         fs,
+				path:       "../../../test/data/changelog/changelog.json",
+				outputPath: "changelog.out",
+				format:     tc.format,
+				feedTitle:  defaultFeedTitle,
+				feedLink:   "https://example.com/changelog",
+			}
+
+			require.NoError(t, opts.Run())
+			b, err := afero.ReadFile(fs, opts.outputPath)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.expected)
+		})
+	}
+}
+
+func TestRender_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *RenderOpts
+		expectedErr string
+	}{
+		{
+			name:        "invalid format",
+			opts:        &RenderOpts{format: "pdf"},
+			expectedErr: "format must be one of markdown, html, atom, rss, got 'pdf'",
+		},
+		{
+			name:        "missing feed link",
+			opts:        &RenderOpts{format: "atom"},
+			expectedErr: "the flag feed-link is required for the atom format",
+		},
+		{
+			name: "valid",
+			opts: &RenderOpts{format: "Markdown"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Sample is a changelog message to run through the rules with its optional expected result.
+type Sample struct {
+	ChangeCode string `yaml:"change_code"`
+	Message    string `yaml:"message"`
+	Expected   string `yaml:"expected,omitempty"`
+}
+
+type TestRulesOpts struct {
+	fs          afero.Fs
+	rulesPath   string
+	samplesPath string
+	message     string
+	changeCode  string
+	language    string
+}
+
+func (o *TestRulesOpts) Run() error {
+	rules := outputfilter.DefaultMessageRulesForLanguage(cmp.Or(o.language, localizations.LangDefault))
+	if o.rulesPath != "" {
+		var err error
+		if rules, err = outputfilter.NewMessageRulesFromPath(o.rulesPath, o.fs); err != nil {
+			return err
+		}
+	}
+
+	samples, err := o.newSamples()
+	if err != nil {
+		return err
+	}
+
+	output, failures := runSamples(rules, samples)
+	fmt.Print(output)
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d samples don't match the expected message", failures, len(samples))
+	}
+	return nil
+}
+
+func (o *TestRulesOpts) newSamples() ([]*Sample, error) {
+	if o.samplesPath == "" {
+		return []*Sample{{ChangeCode: o.changeCode, Message: o.message}}, nil
+	}
+
+	data, err := afero.ReadFile(o.fs, o.samplesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []*Sample
+	if err := yaml.Unmarshal(data, &samples); err != nil {
+		return nil, fmt.Errorf("failed to parse the samples: %w", err)
+	}
+
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no samples found in %s", o.samplesPath)
+	}
+	return samples, nil
+}
+
+// runSamples runs the samples through the rules and returns the report and the number of samples not matching their expected message.
+func runSamples(rules *outputfilter.MessageRules, samples []*Sample) (output string, failures int) {
+	var b strings.Builder
+	for _, sample := range samples {
+		applied, result := rules.Trace(sample.ChangeCode, sample.Message)
+
+		status := "OK"
+		if sample.Expected != "" {
+			status = "PASS"
+			if result != sample.Expected {
+				status = "FAIL"
+				failures++
+			}
+		}
+
+		fmt.Fprintf(&b, "%s [%s] %s\n", status, sample.ChangeCode, sample.Message)
+		fmt.Fprintf(&b, "  result: %s\n", result)
+		if len(applied) > 0 {
+			fmt.Fprintf(&b, "  rules: %s\n", strings.Join(applied, ", "))
+		}
+		if status == "FAIL" {
+			fmt.Fprintf(&b, "  expected: %s\n", sample.Expected)
+		}
+	}
+
+	return b.String(), failures
+}
+
+func (o *TestRulesOpts) PreRunE(_ []string) error {
+	if o.language != "" && !slices.Contains(outputfilter.SupportedLanguages(), o.language) {
+		return fmt.Errorf("unsupported language %q. Supported languages: %s", o.language, strings.Join(outputfilter.SupportedLanguages(), ", "))
+	}
+
+	if o.samplesPath == "" && o.message == "" {
+		return fmt.Errorf("either --%s or --%s must be set", flag.Samples, flag.Message)
+	}
+
+	if o.samplesPath != "" && o.message != "" {
+		return fmt.Errorf("--%s and --%s can't be set together", flag.Samples, flag.Message)
+	}
+
+	return nil
+}
+
+// TestRulesBuilder builds the changelog rules test command with the following signature:
+// changelog rules test --rules rules.yaml --samples samples.yaml.
+func TestRulesBuilder() *cobra.Command {
+	opts := &TestRulesOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "test [--rules rules.yaml] --samples samples.yaml | --message text [--change-code code]",
+		Short: "Run sample changelog messages through the message rewriting rules.",
+		Long: `Run sample changelog messages through the message rewriting rules and print the rewritten messages with the rules applied.
+The default rules shipped with foascli for the --language messages are used unless a rules file is provided with --rules.
+
+The samples file is a YAML list of messages with their change code and optional expected message:
+  - change_code: response-property-default-value-changed
+    message: the 'status' response property default value changed from 'null' to 'ACTIVE'
+    expected: the 'status' response property default value was set to 'ACTIVE'
+
+The command fails if a rewritten message doesn't match its expected message.`,
+		Example: `  # Test the messages of a samples file with a custom rules file:
+  foascli changelog rules test --rules rules.yaml --samples samples.yaml
+
+  # Rewrite a single message with the default rules:
+  foascli changelog rules test --message "added the new 'ACTIVE' enum value for the status '200'"
+
+  # Rewrite a single Russian message with the default rules:
+  foascli changelog rules test --language ru --message "добавлено новое enum значение 'ACTIVE' для статуса '200'"`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.rulesPath, flag.Rules, "", usage.MessageRules)
+	cmd.Flags().StringVar(&opts.samplesPath, flag.Samples, "", usage.MessageSamples)
+	cmd.Flags().StringVar(&opts.message, flag.Message, "", usage.Message)
+	cmd.Flags().StringVar(&opts.changeCode, flag.ChangeCode, "", usage.ChangeCode)
+	cmd.Flags().StringVar(&opts.language, flag.Language, "", usage.Language)
+
+	return cmd
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog/outputfilter"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSamples = `- change_code: response-property-default-value-changed
+  message: the 'status' response property default value changed from 'null' to 'ACTIVE' for the status '200'
+  expected: the 'status' response property default value was set to 'ACTIVE'
+- change_code: response-property-enum-value-added
+  message: added the new 'PAUSED' enum value to the 'state' response property
+`
+
+func TestRulesTest_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "samples.yaml", []byte(testSamples), 0o600))
+
+	opts := &TestRulesOpts{
+		fs:          fs,
+		samplesPath: "samples.yaml",
+	}
+
+	require.NoError(t, opts.Run())
+}
+
+func TestRulesTest_RunWithRules(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "samples.yaml", []byte(testSamples), 0o600))
+	require.NoError(t, afero.WriteFile(fs, "rules.yaml", []byte(`rules:
+  - name: remove-status-code
+    pattern: ' for the status ''\d{3}'''
+    replacement: ''
+`), 0o600))
+
+	opts := &TestRulesOpts{
+		fs:          fs,
+		rulesPath:   "rules.yaml",
+		samplesPath: "samples.yaml",
+	}
+
+	require.ErrorContains(t, opts.Run(), "1 of 2 samples don't match the expected message")
+}
+
+func TestRulesTest_RunMessage(t *testing.T) {
+	opts := &TestRulesOpts{
+		fs:         afero.NewMemMapFs(),
+		message:    "added the new 'ACTIVE' enum value for the status '200'",
+		changeCode: "response-property-enum-value-added",
+	}
+
+	require.NoError(t, opts.Run())
+}
+
+func TestRulesTest_RunLanguage(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "samples.yaml", []byte(`- change_code: response-property-enum-value-added
+  message: добавлено новое enum значение 'ACTIVE' в поле ответа 'state' для ответа со статусом '200'
+  expected: добавлено новое enum значение 'ACTIVE' в поле ответа 'state'
+`), 0o600))
+
+	opts := &TestRulesOpts{
+		fs:          fs,
+		samplesPath: "samples.yaml",
+		language:    "ru",
+	}
+
+	require.NoError(t, opts.Run())
+}
+
+func TestRulesTest_RunInvalidSamples(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "samples.yaml", []byte(""), 0o600))
+
+	opts := &TestRulesOpts{
+		fs:          fs,
+		samplesPath: "samples.yaml",
+	}
+
+	require.ErrorContains(t, opts.Run(), "no samples found")
+}
+
+func TestRunSamples(t *testing.T) {
+	samples := []*Sample{
+		{
+			ChangeCode: "response-property-default-value-changed",
+			Message:    "the 'status' response property default value changed from 'null' to 'ACTIVE'",
+			Expected:   "the 'status' response property default value was set to 'ACTIVE'",
+		},
+		{
+			ChangeCode: "response-property-enum-value-added",
+			Message:    "added the new 'ACTIVE' enum value for the status '200'",
+			Expected:   "added the new 'ACTIVE' enum value for the status '200'",
+		},
+	}
+
+	output, failures := runSamples(outputfilter.DefaultMessageRules(), samples)
+	assert.Equal(t, 1, failures)
+	assert.Contains(t, output, "PASS [response-property-default-value-changed]")
+	assert.Contains(t, output, "  rules: set-value-set\n")
+	assert.Contains(t, output, "FAIL [response-property-enum-value-added]")
+	assert.Contains(t, output, "  result: added the new 'ACTIVE' enum value\n")
+	assert.Contains(t, output, "  expected: added the new 'ACTIVE' enum value for the status '200'\n")
+}
+
+func TestRulesTest_PreRunE(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    *TestRulesOpts
+		wantErr string
+	}{
+		{
+			name: "samples",
+			opts: &TestRulesOpts{samplesPath: "samples.yaml"},
+		},
+		{
+			name: "message",
+			opts: &TestRulesOpts{message: "message"},
+		},
+		{
+			name:    "missing samples and message",
+			opts:    &TestRulesOpts{},
+			wantErr: "either --samples or --message must be set",
+		},
+		{
+			name:    "samples and message",
+			opts:    &TestRulesOpts{samplesPath: "samples.yaml", message: "message"},
+			wantErr: "--samples and --message can't be set together",
+		},
+		{
+			name: "language",
+			opts: &TestRulesOpts{message: "message", language: "pt-br"},
+		},
+		{
+			name:    "unsupported language",
+			opts:    &TestRulesOpts{message: "message", language: "fr"},
+			wantErr: `unsupported language "fr"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/changelog/convert"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var digestExtensions = map[string]string{
+	markdownFormat: "md",
+	jsonFormat:     "json",
+}
+
+type SplitByTeamOpts struct {
+	fs         afero.Fs
+	path       string
+	outputPath string
+	format     string
+	query      changelog.Query
+}
+
+func (o *SplitByTeamOpts) Run() error {
+	entries, err := changelog.NewEntriesFromPath(o.path)
+	if err != nil {
+		return err
+	}
+
+	if err := o.fs.MkdirAll(o.outputPath, 0o755); err != nil {
+		return err
+	}
+
+	for team, teamEntries := range changelog.SplitByTeam(o.query.Apply(entries)) {
+		bytes, err := newTeamDigest(team, teamEntries, o.format)
+		if err != nil {
+			return err
+		}
+
+		fileName := fmt.Sprintf("%s/%s.%s", o.outputPath, teamFileName(team), digestExtensions[o.format])
+		if err := afero.WriteFile(o.fs, fileName, bytes, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newTeamDigest returns the changes of the team, including the hidden spec corrections.
+func newTeamDigest(team string, entries []*changelog.Entry, format string) ([]byte, error) {
+	if format == jsonFormat {
+		return json.MarshalIndent(entries, "", "  ")
+	}
+
+	return []byte(convert.NewMarkdownSummary(entries, team+" Changelog Digest", true)), nil
+}
+
+// teamFileName returns the team name in lowercase with the words separated by dashes,
+// for example search-catalog-deployments for Search Catalog & Deployments.
+func teamFileName(team string) string {
+	words := strings.FieldsFunc(strings.ToLower(team), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+func (o *SplitByTeamOpts) PreRunE(_ []string) error {
+	for _, date := range []string{o.query.From, o.query.To} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return fmt.Errorf("invalid date %q, use the format YYYY-MM-DD", date)
+		}
+	}
+
+	if _, ok := digestExtensions[o.format]; !ok {
+		return fmt.Errorf("format must be either 'markdown' or 'json', got '%s'", o.format)
+	}
+
+	return nil
+}
+
+// SplitByTeamBuilder builds the changelog split-by-team command with the following signature:
+// changelog split-by-team -p changelog-all.json --from 2025-03-01 -o digests.
+func SplitByTeamBuilder() *cobra.Command {
+	opts := &SplitByTeamOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "split-by-team -p path_to_changelog -o output_folder",
+		Short: "Split the changelog into one digest per owner team.",
+		Long: `Split the changelog into one digest per owner team (x-xgen-owner-team), including the hidden spec corrections.
+Use the changelog with the hidden changes (internal/changelog-all.json), which includes the owner team of each path.
+The changes without owner team are written to the unknown digest.`,
+		Example: `  # Markdown digests of the changes since March 2025:
+  foascli changelog split-by-team -p changelog/internal/changelog-all.json --from 2025-03-01 -o digests`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.path, flag.Path, flag.PathShort, "", usage.Path)
+	cmd.Flags().StringVar(&opts.query.From, flag.From, "", usage.From)
+	cmd.Flags().StringVar(&opts.query.To, flag.To, "", usage.To)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, markdownFormat, usage.DigestFormat)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.DigestOutput)
+
+	_ = cmd.MarkFlagRequired(flag.Path)
+	_ = cmd.MarkFlagRequired(flag.Output)
+	return cmd
+}
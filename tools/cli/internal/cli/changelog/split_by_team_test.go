@@ -0,0 +1,188 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTeamEntries() []*changelog.Entry {
+	return []*changelog.Entry{
+		{
+			Date: "2025-03-12",
+			Paths: []*changelog.Path{
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/clusters",
+					HTTPMethod:  "GET",
+					OperationID: "listClusters",
+					Tag:         "Clusters",
+					OwnerTeam:   "Atlas Dedicated",
+					Versions: []*changelog.Version{
+						{
+							Version: "2024-08-05",
+							Changes: []*changelog.Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+								{Description: "fixed the type", Code: "response-property-type-changed", HideFromChangelog: true},
+							},
+						},
+					},
+				},
+				{
+					URI:         "/api/atlas/v2/groups/{groupId}/streams",
+					HTTPMethod:  "GET",
+					OperationID: "listStreamInstances",
+					Tag:         "Streams",
+					OwnerTeam:   "Search Catalog & Deployments",
+					Versions: []*changelog.Version{
+						{
+							Version: "2023-02-01",
+							Changes: []*changelog.Change{
+								{Description: "added the property 'name'", Code: "response-optional-property-added", BackwardCompatible: true},
+							},
+						},
+					},
+				},
+				{
+					URI:         "/api/atlas/v2/groups",
+					HTTPMethod:  "GET",
+					OperationID: "listProjects",
+					Tag:         "Projects",
+					Versions: []*changelog.Version{
+						{
+							Version: "2023-01-01",
+							Changes: []*changelog.Change{
+								{Description: "removed the property 'id'", Code: "response-property-removed"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSplitByTeam_Run(t *testing.T) {
+	contents, err := json.Marshal(newTeamEntries())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "changelog-all.json")
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+
+	fs := afero.NewMemMapFs()
+	opts := &SplitByTeamOpts{
+		fs:         fs,
+		path:       path,
+		outputPath: "digests",
+		format:     markdownFormat,
+	}
+
+	require.NoError(t, opts.Run())
+
+	digest, err := afero.ReadFile(fs, "digests/atlas-dedicated.md")
+	require.NoError(t, err)
+	expected := "# Atlas Dedicated Changelog Digest\n\n" +
+		"## Breaking Changes\n\n" +
+		"- `GET /api/atlas/v2/groups/{groupId}/clusters` (2024-08-05): removed the property 'id'\n\n" +
+		"## Spec Corrections\n\n" +
+		"- `GET /api/atlas/v2/groups/{groupId}/clusters` (2024-08-05): fixed the type\n"
+	assert.Equal(t, expected, string(digest))
+
+	for _, fileName := range []string{"digests/search-catalog-deployments.md", "digests/unknown.md"} {
+		exists, err := afero.Exists(fs, fileName)
+		require.NoError(t, err)
+		assert.True(t, exists, fileName)
+	}
+}
+
+func TestSplitByTeam_RunJSON(t *testing.T) {
+	contents, err := json.Marshal(newTeamEntries())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "changelog-all.json")
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+
+	fs := afero.NewMemMapFs()
+	opts := &SplitByTeamOpts{
+		fs:         fs,
+		path:       path,
+		outputPath: "digests",
+		format:     jsonFormat,
+		query:      changelog.Query{From: "2025-04-01"},
+	}
+
+	require.NoError(t, opts.Run())
+
+	files, err := afero.ReadDir(fs, "digests")
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	opts.query = changelog.Query{}
+	require.NoError(t, opts.Run())
+
+	b, err := afero.ReadFile(fs, "digests/unknown.json")
+	require.NoError(t, err)
+	var entries []*changelog.Entry
+	require.NoError(t, json.Unmarshal(b, &entries))
+	require.Len(t, entries, 1)
+	require.Len(t, entries[0].Paths, 1)
+	assert.Equal(t, "listProjects", entries[0].Paths[0].OperationID)
+}
+
+func TestTeamFileName(t *testing.T) {
+	assert.Equal(t, "search-catalog-deployments", teamFileName("Search Catalog & Deployments"))
+	assert.Equal(t, "backup-atlas", teamFileName("Backup - Atlas"))
+	assert.Equal(t, "intel-ii", teamFileName("Intel II"))
+	assert.Equal(t, "apix", teamFileName("apix"))
+}
+
+func TestSplitByTeam_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *SplitByTeamOpts
+		expectedErr string
+	}{
+		{
+			name:        "invalid date",
+			opts:        &SplitByTeamOpts{format: markdownFormat, query: changelog.Query{To: "March"}},
+			expectedErr: `invalid date "March", use the format YYYY-MM-DD`,
+		},
+		{
+			name:        "invalid format",
+			opts:        &SplitByTeamOpts{format: tableFormat},
+			expectedErr: "format must be either 'markdown' or 'json', got 'table'",
+		},
+		{
+			name: "valid",
+			opts: &SplitByTeamOpts{format: jsonFormat, query: changelog.Query{From: "2025-03-01"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
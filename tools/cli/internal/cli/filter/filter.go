@@ -59,6 +59,11 @@ func (o *Opts) Run() error {
 		return err
 	}
 
+	// Remove the components no longer used after filtering and fail on dangling references
+	if err := filter.CleanupRefs(filteredOAS); err != nil {
+		return err
+	}
+
 	return openapi.Save(o.outputPath, filteredOAS, o.format, o.fs)
 }
 
@@ -112,8 +117,9 @@ func Builder() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use: "filter -s spec ",
-		Short: `Filter Open API specification removing hidden endpoints and extension metadata. 
-If a version is provided, versioning filters will also be applied.`,
+		Short: `Filter Open API specification removing hidden endpoints, extension metadata and unused components. 
+If a version is provided, versioning filters will also be applied.
+The command fails if the filtered specification contains dangling references.`,
 		Args: cobra.NoArgs,
 		PreRunE: func(_ *cobra.Command, args []string) error {
 			return opts.PreRunE(args)
@@ -55,4 +55,50 @@ const (
 	Tags                     = "tags"
 	Paths                    = "paths"
 	KeepIPAExceptions        = "keep-ipa-exceptions"
+	ExcludeOperationIDs      = "exclude-ids"
+	ExcludeTags              = "exclude-tags"
+	ExcludePaths             = "exclude-paths"
+	Methods                  = "methods"
+	ExcludeMethods           = "exclude-methods"
+	MatchAll                 = "all"
+	OwnerTeams               = "owner-teams"
+	ExcludeOwnerTeams        = "exclude-owner-teams"
+	Deprecated               = "deprecated"
+	ExcludeDeprecated        = "exclude-deprecated"
+	ExcludeStabilityLevel    = "exclude-stability-level"
+	PreviewNames             = "preview-names"
+	ExcludePreviewNames      = "exclude-preview-names"
+	SunsetFrom               = "sunset-from"
+	SunsetTo                 = "sunset-to"
+	Schema                   = "schema"
+	Detailed                 = "detailed"
+	SunsetOffset             = "sunset-offset"
+	MinSunsetDays            = "min-sunset-days"
+	FeedTitle                = "feed-title"
+	FeedLink                 = "feed-link"
+	Title                    = "title"
+	IncludeHidden            = "include-hidden"
+	IncludePreviews          = "include-previews"
+	Ops                      = "ops"
+	Since                    = "since"
+	Template                 = "template"
+	BatchSize                = "batch-size"
+	Blocks                   = "blocks"
+	MaxMessages              = "max-messages"
+	ChangelogLink            = "changelog-link"
+	PathPrefix               = "path-prefix"
+	ChangeCodes              = "change-codes"
+	BackwardCompatible       = "backward-compatible"
+	Hidden                   = "hidden"
+	Metadata                 = "metadata"
+	MetadataShort            = "m"
+	SnapshotsDir             = "snapshots-dir"
+	Repo                     = "repo"
+	SpecDir                  = "spec-dir"
+	ChangelogDir             = "changelog-dir"
+	Rules                    = "rules"
+	Samples                  = "samples"
+	Message                  = "message"
+	ChangeCode               = "change-code"
+	Language                 = "language"
 )
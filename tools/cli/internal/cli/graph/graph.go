@@ -0,0 +1,162 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/filter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	openapifilter "github.com/mongodb/openapi/tools/cli/internal/openapi/filter"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/graph"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/slice"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type Opts struct {
+	fs           afero.Fs
+	basePath     string
+	outputPath   string
+	format       string
+	env          string
+	version      string
+	operationIDs []string
+	tags         []string
+	paths        []string
+}
+
+func (o *Opts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	spec, err := o.filteredSpec(specInfo.Spec)
+	if err != nil {
+		return err
+	}
+
+	g, err := graph.New(spec)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := o.graphAsBytes(g)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+// filteredSpec filters the spec by version and environment, and slices the operations if any criteria is provided.
+func (o *Opts) filteredSpec(spec *openapi3.T) (*openapi3.T, error) {
+	var err error
+	if o.version != "" {
+		spec, err = filter.ByVersion(spec, o.version, o.env, false)
+	} else {
+		spec, err = openapifilter.ApplyFilters(spec, openapifilter.NewMetadata(nil, o.env), openapifilter.FiltersWithoutVersioning)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(o.operationIDs) == 0 && len(o.tags) == 0 && len(o.paths) == 0 {
+		return spec, nil
+	}
+
+	err = slice.Slice(spec, &slice.Criteria{
+		OperationIDs: o.operationIDs,
+		Tags:         o.tags,
+		Paths:        o.paths,
+	})
+	return spec, err
+}
+
+func (o *Opts) graphAsBytes(g *graph.Graph) ([]byte, error) {
+	switch o.format {
+	case graph.DOT:
+		return []byte(g.DOT()), nil
+	case graph.Mermaid:
+		return []byte(g.Mermaid()), nil
+	default:
+		return openapi.SerializeToJSON(g)
+	}
+}
+
+func (o *Opts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
+	}
+
+	if o.format != graph.DOT && o.format != graph.Mermaid && o.format != graph.JSON {
+		return fmt.Errorf("format must be either 'dot', 'mermaid' or 'json', got '%s'", o.format)
+	}
+
+	return nil
+}
+
+// Builder builds the graph command with the following signature:
+// graph -s spec --version 2024-08-05 --env prod -f dot|mermaid|json --ids "op1,op2" --tags "tag1" --paths "/api/v1".
+func Builder() *cobra.Command {
+	opts := &Opts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "graph -s spec",
+		Short: "Export the operation to schema and schema to schema reference graph of an OpenAPI specification.",
+		Long: `Graph exports the references from the operations to the component schemas, and between
+component schemas, as a DOT, Mermaid or JSON graph. Only the schemas reachable from the
+operations are included.
+
+If a version is provided, the graph is computed on the specification filtered by that version.
+The graph can be limited to a slice of operations by operation IDs, tags or paths.`,
+		Example: `  # Export the graph of the 2024-08-05 version as DOT:
+  foascli graph -s spec.yaml --version 2024-08-05 -o graph.dot
+
+  # Export the graph of the cluster operations as Mermaid:
+  foascli graph -s spec.yaml --version 2024-08-05 --tags "Clusters" -f mermaid`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, graph.DOT, usage.GraphFormat)
+	cmd.Flags().StringVar(&opts.env, flag.Environment, "prod", usage.Environment)
+	cmd.Flags().StringVar(&opts.version, flag.Version, "", usage.Version)
+	cmd.Flags().StringSliceVar(&opts.operationIDs, flag.OperationIDs, []string{}, usage.OperationIDs)
+	cmd.Flags().StringSliceVar(&opts.tags, flag.Tags, []string{}, usage.Tags)
+	cmd.Flags().StringSliceVar(&opts.paths, flag.Paths, []string{}, usage.Paths)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	return cmd
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package graph
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_Run(t *testing.T) {
+	testCases := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{name: "dot", format: "dot", expected: "digraph openapi {"},
+		{name: "mermaid", format: "mermaid", expected: "flowchart LR"},
+		{name: "json", format: "json", expected: `"nodes": [`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			opts := &Opts{
+				basePath:   "../../../test/data/base_spec.json",
+				outputPath: "graph.out",
+				format:     tc.format,
+				env:        "prod",
+				version:    "2023-02-01",
+				fs:         fs,
+			}
+
+			require.NoError(t, opts.Run())
+			b, err := afero.ReadFile(fs, opts.outputPath)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.expected)
+		})
+	}
+}
+
+func TestGraph_RunWithSlice(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:     "../../../test/data/base_spec.json",
+		outputPath:   "graph.dot",
+		format:       "dot",
+		env:          "prod",
+		version:      "2023-02-01",
+		operationIDs: []string{"listProjects"},
+		fs:           fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"operation:listProjects"`)
+	assert.NotContains(t, string(b), `"operation:createProject"`)
+}
+
+func TestGraph_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *Opts
+		expectedErr string
+	}{
+		{
+			name:        "missing spec",
+			opts:        &Opts{format: "dot"},
+			expectedErr: "no OAS detected",
+		},
+		{
+			name:        "invalid format",
+			opts:        &Opts{basePath: "spec.json", format: "svg"},
+			expectedErr: "format must be either 'dot', 'mermaid' or 'json'",
+		},
+		{
+			name: "valid",
+			opts: &Opts{basePath: "spec.json", format: "mermaid"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
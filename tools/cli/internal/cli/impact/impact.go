@@ -0,0 +1,283 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/filter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/graph"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	schemaRefPrefix    = "#/components/schemas/"
+	ownerTeamExtension = "x-xgen-owner-team"
+)
+
+type Opts struct {
+	fs         afero.Fs
+	basePath   string
+	outputPath string
+	format     string
+	schema     string
+	envs       []string
+	versions   []string
+}
+
+// Impact lists the operations that reference a schema, directly or transitively.
+type Impact struct {
+	Schema     string       `json:"schema" yaml:"schema"`
+	Operations []*Operation `json:"operations" yaml:"operations"`
+}
+
+// Operation is an operation that references the schema, together with the versions and
+// environments where the reference exists.
+type Operation struct {
+	OperationID string   `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Method      string   `json:"method" yaml:"method"`
+	Path        string   `json:"path" yaml:"path"`
+	OwnerTeam   string   `json:"ownerTeam,omitempty" yaml:"ownerTeam,omitempty"`
+	Usages      []*Usage `json:"usages" yaml:"usages"`
+}
+
+// Usage is a reference to the schema in the request or response of an operation for a given version.
+type Usage struct {
+	Version      string   `json:"version" yaml:"version"`
+	Location     string   `json:"location" yaml:"location"`
+	Environments []string `json:"environments" yaml:"environments"`
+}
+
+func (o *Opts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	impact, err := o.newImpact(specInfo.Spec)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := o.impactAsBytes(impact)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+// newImpact filters the spec for each environment and version and collects the operations
+// that reference the schema in the resulting graph.
+func (o *Opts) newImpact(spec *openapi3.T) (*Impact, error) {
+	// The owner team extension is removed when filtering by version, so it is read from the source spec
+	ownerTeams := operationOwnerTeams(spec)
+	operations := make(map[string]*Operation)
+
+	for _, env := range o.envs {
+		versions, err := o.envVersions(spec, env)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, version := range versions {
+			filteredSpec, err := filter.ByVersion(spec, version, env, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter by version %q and env %q: %w", version, env, err)
+			}
+
+			g, err := graph.New(filteredSpec)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, edge := range g.OperationEdgesTo(o.schema) {
+				node := g.Node(edge.From)
+				operation, ok := operations[node.ID]
+				if !ok {
+					operation = &Operation{
+						Method:    node.Method,
+						Path:      node.Path,
+						OwnerTeam: ownerTeams[node.Method+" "+node.Path],
+					}
+					if node.Label != node.Method+" "+node.Path {
+						operation.OperationID = node.Label
+					}
+					operations[node.ID] = operation
+				}
+				operation.addUsage(version, edge.Location, env)
+			}
+		}
+	}
+
+	impact := &Impact{
+		Schema:     o.schema,
+		Operations: make([]*Operation, 0, len(operations)),
+	}
+	for _, operation := range operations {
+		operation.sortUsages()
+		impact.Operations = append(impact.Operations, operation)
+	}
+	slices.SortFunc(impact.Operations, func(a, b *Operation) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+
+	log.Printf("Found %d operations referencing the schema %q", len(impact.Operations), o.schema)
+	return impact, nil
+}
+
+func (o *Opts) envVersions(spec *openapi3.T, env string) ([]string, error) {
+	if len(o.versions) > 0 {
+		return o.versions, nil
+	}
+
+	return openapi.ExtractVersionsWithEnv(spec, env)
+}
+
+func (op *Operation) addUsage(version, location, env string) {
+	for _, u := range op.Usages {
+		if u.Version == version && u.Location == location {
+			if !slices.Contains(u.Environments, env) {
+				u.Environments = append(u.Environments, env)
+			}
+			return
+		}
+	}
+
+	op.Usages = append(op.Usages, &Usage{Version: version, Location: location, Environments: []string{env}})
+}
+
+func (op *Operation) sortUsages() {
+	slices.SortFunc(op.Usages, func(a, b *Usage) int {
+		return cmp.Or(cmp.Compare(a.Version, b.Version), cmp.Compare(a.Location, b.Location))
+	})
+}
+
+// operationOwnerTeams returns the x-xgen-owner-team of each operation, keyed by "METHOD path".
+func operationOwnerTeams(spec *openapi3.T) map[string]string {
+	teams := make(map[string]string)
+	if spec.Paths == nil {
+		return teams
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			if team, ok := operation.Extensions[ownerTeamExtension].(string); ok {
+				teams[method+" "+path] = team
+			}
+		}
+	}
+
+	return teams
+}
+
+func (o *Opts) impactAsBytes(impact *Impact) ([]byte, error) {
+	data, err := json.MarshalIndent(impact, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if format := strings.ToLower(o.format); format == "json" {
+		return data, nil
+	}
+
+	var jsonData any
+	if mErr := json.Unmarshal(data, &jsonData); mErr != nil {
+		return nil, mErr
+	}
+
+	return yaml.Marshal(jsonData)
+}
+
+func (o *Opts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
+	}
+
+	o.schema = strings.TrimPrefix(o.schema, schemaRefPrefix)
+	if o.schema == "" {
+		return fmt.Errorf("no schema provided. Please, use the flag %s to set the schema name", flag.Schema)
+	}
+
+	if len(o.envs) == 0 {
+		return fmt.Errorf("at least one environment must be provided with the flag %s", flag.Environment)
+	}
+
+	if o.format != "json" && o.format != "yaml" {
+		return fmt.Errorf("output format must be either 'json' or 'yaml', got %q", o.format)
+	}
+
+	return nil
+}
+
+// Builder builds the impact command with the following signature:
+// impact -s spec --schema ClusterDescription20240805 --env dev,prod --version 2024-08-05.
+func Builder() *cobra.Command {
+	opts := &Opts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "impact -s spec --schema name",
+		Short: "List the operations, versions and environments that reference a schema.",
+		Long: `Impact lists the operations that reference a component schema, either directly or
+transitively through other component schemas, for each API version and environment.
+
+For each operation, the output includes the operationId, the owner team (x-xgen-owner-team)
+and whether the schema is referenced in the request or in the response.
+
+If no version is provided, all the versions available in each environment are considered.`,
+		Example: `  # List the operations referencing a schema in all the prod versions:
+  foascli impact -s openapi-foas.json --schema ClusterDescription20240805
+
+  # List the operations referencing a schema in a single version across environments:
+  foascli impact -s openapi-foas.json --schema "#/components/schemas/ApiError" --env dev,prod --version 2024-08-05`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVar(&opts.schema, flag.Schema, "", usage.Schema)
+	cmd.Flags().StringSliceVar(&opts.envs, flag.Environment, []string{"prod"}, usage.Environments)
+	cmd.Flags().StringSliceVar(&opts.versions, flag.Version, []string{}, usage.Version)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, "json", usage.Format)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	_ = cmd.MarkFlagRequired(flag.Schema)
+	return cmd
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpact_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:   "../../../test/data/base_spec.json",
+		outputPath: "impact.json",
+		format:     "json",
+		schema:     "Group",
+		envs:       []string{"dev", "prod"},
+		versions:   []string{"2023-01-01"},
+		fs:         fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+
+	var impact Impact
+	require.NoError(t, json.Unmarshal(b, &impact))
+	assert.Equal(t, "Group", impact.Schema)
+
+	operations := make(map[string]*Operation)
+	for _, operation := range impact.Operations {
+		operations[operation.OperationID] = operation
+	}
+
+	require.Contains(t, operations, "listProjects")
+	assert.Equal(t, "IAM", operations["listProjects"].OwnerTeam)
+	assert.Equal(t, []*Usage{
+		{Version: "2023-01-01", Location: "response", Environments: []string{"dev", "prod"}},
+	}, operations["listProjects"].Usages)
+
+	require.Contains(t, operations, "createProject")
+	assert.Equal(t, []*Usage{
+		{Version: "2023-01-01", Location: "request", Environments: []string{"dev", "prod"}},
+		{Version: "2023-01-01", Location: "response", Environments: []string{"dev", "prod"}},
+	}, operations["createProject"].Usages)
+}
+
+func TestImpact_RunSchemaInComponentResponse(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:   "../../../test/data/base_spec.json",
+		outputPath: "impact.json",
+		format:     "json",
+		schema:     "ApiError",
+		envs:       []string{"prod"},
+		versions:   []string{"2023-01-01"},
+		fs:         fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+
+	var impact Impact
+	require.NoError(t, json.Unmarshal(b, &impact))
+
+	// ApiError is only referenced through the shared #/components/responses of the filtered spec
+	operations := make(map[string]*Operation)
+	for _, operation := range impact.Operations {
+		operations[operation.OperationID] = operation
+	}
+	assert.Greater(t, len(operations), 100)
+	require.Contains(t, operations, "listProjects")
+	assert.Equal(t, []*Usage{
+		{Version: "2023-01-01", Location: "response", Environments: []string{"prod"}},
+	}, operations["listProjects"].Usages)
+}
+
+func TestImpact_RunUnreferencedSchema(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:   "../../../test/data/base_spec.json",
+		outputPath: "impact.yaml",
+		format:     "yaml",
+		schema:     "NotExistingSchema",
+		envs:       []string{"prod"},
+		versions:   []string{"2023-01-01"},
+		fs:         fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "operations: []")
+}
+
+func TestOperation_AddUsage(t *testing.T) {
+	operation := &Operation{}
+	operation.addUsage("2024-08-05", "response", "prod")
+	operation.addUsage("2023-01-01", "request", "prod")
+	operation.addUsage("2024-08-05", "response", "dev")
+	operation.addUsage("2024-08-05", "response", "dev")
+	operation.sortUsages()
+
+	assert.Equal(t, []*Usage{
+		{Version: "2023-01-01", Location: "request", Environments: []string{"prod"}},
+		{Version: "2024-08-05", Location: "response", Environments: []string{"prod", "dev"}},
+	}, operation.Usages)
+}
+
+func TestImpact_PreRun(t *testing.T) {
+	testCases := []struct {
+		name           string
+		opts           *Opts
+		expectedErr    string
+		expectedSchema string
+	}{
+		{
+			name:        "missing spec",
+			opts:        &Opts{schema: "Group", envs: []string{"prod"}, format: "json"},
+			expectedErr: "no OAS detected",
+		},
+		{
+			name:        "missing schema",
+			opts:        &Opts{basePath: "spec.json", schema: "#/components/schemas/", envs: []string{"prod"}, format: "json"},
+			expectedErr: "no schema provided",
+		},
+		{
+			name:        "missing env",
+			opts:        &Opts{basePath: "spec.json", schema: "Group", format: "json"},
+			expectedErr: "at least one environment must be provided",
+		},
+		{
+			name:        "invalid format",
+			opts:        &Opts{basePath: "spec.json", schema: "Group", envs: []string{"prod"}, format: "csv"},
+			expectedErr: "output format must be either 'json' or 'yaml'",
+		},
+		{
+			name:           "schema ref",
+			opts:           &Opts{basePath: "spec.json", schema: "#/components/schemas/Group", envs: []string{"prod"}, format: "yaml"},
+			expectedSchema: "Group",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSchema, tc.opts.schema)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/lifecycle"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type LifecycleOpts struct {
+	fs            afero.Fs
+	basePath      string
+	outputPath    string
+	format        string
+	minSunsetDays int
+	now           time.Time
+}
+
+func (o *LifecycleOpts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	violations := lifecycle.Lint(specInfo.Spec, lifecycle.Config{
+		MinSunsetDays: o.minSunsetDays,
+		Now:           o.now,
+	})
+	if violations == nil {
+		violations = []*lifecycle.Violation{}
+	}
+
+	bytes, err := violationsAsBytes(violations, o.format)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		err = afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	} else {
+		fmt.Println(string(bytes))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("found %d lifecycle policy violations", len(violations))
+	}
+	return nil
+}
+
+func violationsAsBytes(violations []*lifecycle.Violation, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(violations, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ToLower(format) == openapi.JSON {
+		return data, nil
+	}
+
+	var jsonData any
+	if mErr := json.Unmarshal(data, &jsonData); mErr != nil {
+		return nil, mErr
+	}
+
+	return yaml.Marshal(jsonData)
+}
+
+func (o *LifecycleOpts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
+	}
+
+	if o.minSunsetDays < 0 {
+		return errors.New("the minimum number of sunset days must not be negative")
+	}
+
+	if format := strings.ToLower(o.format); format != openapi.JSON && format != openapi.YAML {
+		return fmt.Errorf("format must be either 'json' or 'yaml', got '%s'", o.format)
+	}
+
+	return nil
+}
+
+// LifecycleBuilder builds the lint lifecycle command with the following signature:
+// lint lifecycle -s spec.json --min-sunset-days 365 -f json.
+func LifecycleBuilder() *cobra.Command {
+	opts := &LifecycleOpts{
+		fs:  afero.NewOsFs(),
+		now: time.Now(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lifecycle -s spec.json",
+		Short: "Verify the version and sunset dates of the OpenAPI spec against the API lifecycle policy.",
+		Long: `Verify the version and sunset dates of the OpenAPI spec against the API lifecycle policy:
+  - missing-sunset: a response content version superseded by a newer version has no x-sunset.
+  - sunset-too-early: the x-sunset is less than the minimum number of days after the superseding version date.
+  - sunset-before-version: the x-sunset is earlier than the date of its own version.
+  - invalid-sunset: the x-sunset is not a date (YYYY-MM-DD).
+  - upcoming-in-past: an upcoming version date is not in the future.
+  - placeholder-not-allowed: the 9999-12-31 placeholder is used outside success responses and operations.
+
+Violations are reported with the JSON pointer of the offending value. The command fails if any violation is found.`,
+		Example: `  # Lint the lifecycle policy with a minimum of 180 days before sunset:
+  foascli lint lifecycle -s openapi-mms.json --min-sunset-days 180`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().IntVar(&opts.minSunsetDays, flag.MinSunsetDays, lifecycle.DefaultMinSunsetDays, usage.MinSunsetDays)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, openapi.JSON, usage.Format)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	return cmd
+}
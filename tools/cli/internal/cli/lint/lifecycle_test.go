@@ -0,0 +1,141 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/lifecycle"
+	"github.com/mongodb/openapi/tools/cli/internal/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		LifecycleBuilder(),
+		0,
+		[]string{flag.Spec, flag.MinSunsetDays, flag.Output, flag.Format},
+	)
+}
+
+func TestLifecycle_Run(t *testing.T) {
+	testCases := []struct {
+		name          string
+		minSunsetDays int
+		now           time.Time
+		wantErr       string
+		want          []*lifecycle.Violation
+	}{
+		{
+			name:          "valid",
+			minSunsetDays: 90,
+			now:           time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:          []*lifecycle.Violation{},
+		},
+		{
+			name:          "violations",
+			minSunsetDays: lifecycle.DefaultMinSunsetDays,
+			now:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:       "found 2 lifecycle policy violations",
+			want: []*lifecycle.Violation{
+				{
+					Rule:        lifecycle.SunsetTooEarlyRule,
+					Pointer:     "/paths/~1api~1atlas~1v2~1openapi~1info/get/responses/200/content/application~1vnd.atlas.2024-05-30+json/x-sunset",
+					OperationID: "getOpenApiInfo",
+					Message:     "sunset 2025-05-30 is less than 365 days after the superseding version 2024-08-05",
+				},
+				{
+					Rule:        lifecycle.UpcomingInPastRule,
+					Pointer:     "/paths/~1api~1atlas~1v2~1openapi~1info/get/responses/200/content/application~1vnd.atlas.2025-09-22.upcoming+json",
+					OperationID: "getOpenApiInfo",
+					Message:     "upcoming version 2025-09-22.upcoming is not in the future",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			opts := &LifecycleOpts{
+				fs:            fs,
+				basePath:      "../../../test/data/openapi_with_upcoming.json",
+				outputPath:    "violations.json",
+				format:        "json",
+				minSunsetDays: tc.minSunsetDays,
+				now:           tc.now,
+			}
+
+			err := opts.Run()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			b, err := afero.ReadFile(fs, opts.outputPath)
+			require.NoError(t, err)
+
+			var violations []*lifecycle.Violation
+			require.NoError(t, json.Unmarshal(b, &violations))
+			assert.Equal(t, tc.want, violations)
+		})
+	}
+}
+
+func TestLifecycle_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *LifecycleOpts
+		expectedErr string
+	}{
+		{
+			name:        "missing spec",
+			opts:        &LifecycleOpts{format: "json"},
+			expectedErr: "no OAS detected",
+		},
+		{
+			name:        "negative min sunset days",
+			opts:        &LifecycleOpts{basePath: "spec.json", format: "json", minSunsetDays: -1},
+			expectedErr: "the minimum number of sunset days must not be negative",
+		},
+		{
+			name:        "invalid format",
+			opts:        &LifecycleOpts{basePath: "spec.json", format: "csv"},
+			expectedErr: "format must be either 'json' or 'yaml'",
+		},
+		{
+			name: "valid",
+			opts: &LifecycleOpts{basePath: "spec.json", format: "yaml", minSunsetDays: 365},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
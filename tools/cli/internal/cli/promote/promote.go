@@ -0,0 +1,125 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/promote"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type Opts struct {
+	fs         afero.Fs
+	basePath   string
+	outputPath string
+	format     string
+	version    string
+}
+
+func (o *Opts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	operations, err := promote.Upcoming(specInfo.Spec, o.version)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(summary(o.version, operations))
+	return openapi.Save(o.outputPath, specInfo.Spec, o.format, o.fs)
+}
+
+// summary returns the list of promoted operations with their content types.
+func summary(version string, operations []*promote.Operation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Promoted %d operations from %q to %q:\n", len(operations), version, strings.TrimSuffix(version, ".upcoming"))
+	for _, operation := range operations {
+		fmt.Fprintf(&b, "  - %s %s", operation.Method, operation.Path)
+		if operation.OperationID != "" {
+			fmt.Fprintf(&b, " (%s)", operation.OperationID)
+		}
+		if len(operation.ContentTypes) > 0 {
+			fmt.Fprintf(&b, ": %s", strings.Join(operation.ContentTypes, ", "))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (o *Opts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
+	}
+
+	version, err := apiversion.New(apiversion.WithVersion(o.version))
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", o.version, err)
+	}
+
+	if !version.IsUpcoming() {
+		return fmt.Errorf("version must be an upcoming version (YYYY-MM-DD.upcoming), got %q", o.version)
+	}
+
+	return openapi.ValidateFormatAndOutput(o.format, o.outputPath)
+}
+
+// Builder builds the promote command with the following signature:
+// promote -s spec --version 2026-01-15.upcoming -o spec.json.
+func Builder() *cobra.Command {
+	opts := &Opts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "promote -s spec --version YYYY-MM-DD.upcoming -o output",
+		Short: "Promote an upcoming API version to stable.",
+		Long: `Promote renames the content types of an upcoming API version to the stable content types of the
+same date, e.g. application/vnd.atlas.2026-01-15.upcoming+json to application/vnd.atlas.2026-01-15+json,
+and updates the related x-xgen-version extensions.
+
+The command fails if stable content with the same date already exists, and prints a summary of the
+promoted operations.`,
+		Example: `  # Promote the 2026-01-15.upcoming version:
+  foascli promote -s openapi-mms.json --version 2026-01-15.upcoming -o openapi-mms.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVar(&opts.version, flag.Version, "", usage.UpcomingVersion)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, openapi.JSON, usage.Format)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	_ = cmd.MarkFlagRequired(flag.Version)
+	_ = cmd.MarkFlagRequired(flag.Output)
+	return cmd
+}
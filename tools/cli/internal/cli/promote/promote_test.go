@@ -0,0 +1,98 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/promote"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromote_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:   "../../../test/data/openapi_with_upcoming.json",
+		outputPath: "promoted.json",
+		format:     "json",
+		version:    "2025-09-22.upcoming",
+		fs:         fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "application/vnd.atlas.2025-09-22+json")
+	assert.NotContains(t, string(b), "2025-09-22.upcoming")
+}
+
+func TestPromote_Summary(t *testing.T) {
+	operations := []*promote.Operation{
+		{OperationID: "getCluster", Method: "GET", Path: "/clusters", ContentTypes: []string{"application/vnd.atlas.2026-01-15.upcoming+json"}},
+		{Method: "GET", Path: "/info"},
+	}
+
+	expected := `Promoted 2 operations from "2026-01-15.upcoming" to "2026-01-15":
+  - GET /clusters (getCluster): application/vnd.atlas.2026-01-15.upcoming+json
+  - GET /info
+`
+	assert.Equal(t, expected, summary("2026-01-15.upcoming", operations))
+}
+
+func TestPromote_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *Opts
+		expectedErr string
+	}{
+		{
+			name:        "missing spec",
+			opts:        &Opts{version: "2026-01-15.upcoming", format: "json", outputPath: "spec.json"},
+			expectedErr: "no OAS detected",
+		},
+		{
+			name:        "stable version",
+			opts:        &Opts{basePath: "spec.json", version: "2026-01-15", format: "json", outputPath: "spec.json"},
+			expectedErr: "version must be an upcoming version",
+		},
+		{
+			name:        "invalid version",
+			opts:        &Opts{basePath: "spec.json", version: "next", format: "json", outputPath: "spec.json"},
+			expectedErr: "invalid version",
+		},
+		{
+			name:        "invalid format",
+			opts:        &Opts{basePath: "spec.json", version: "2026-01-15.upcoming", format: "xml", outputPath: "spec.json"},
+			expectedErr: "format must be either 'json', 'yaml' or 'all'",
+		},
+		{
+			name: "valid",
+			opts: &Opts{basePath: "spec.json", version: "2026-01-15.upcoming", format: "json", outputPath: "spec.json"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -21,7 +21,11 @@ import (
 	"github.com/mongodb/openapi/tools/cli/internal/cli/breakingchanges"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/changelog"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/filter"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/graph"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/impact"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/lint"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/merge"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/promote"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/slice"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/split"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/sunset"
@@ -65,6 +69,10 @@ func Builder() *cobra.Command {
 		sunset.Builder(),
 		filter.Builder(),
 		slice.Builder(),
+		graph.Builder(),
+		impact.Builder(),
+		promote.Builder(),
+		lint.Builder(),
 	)
 	return rootCmd
 }
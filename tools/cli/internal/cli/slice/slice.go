@@ -18,7 +18,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
 	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
 	"github.com/mongodb/openapi/tools/cli/internal/openapi"
@@ -28,13 +31,31 @@ import (
 )
 
 type Opts struct {
-	fs           afero.Fs
-	basePath     string
-	outputPath   string
-	format       string
-	operationIDs []string
-	tags         []string
-	paths        []string
+	fs                  afero.Fs
+	basePath            string
+	outputPath          string
+	format              string
+	operationIDs        []string
+	tags                []string
+	paths               []string
+	methods             []string
+	excludeOperationIDs []string
+	excludeTags         []string
+	excludePaths        []string
+	excludeMethods      []string
+	ownerTeams          []string
+	excludeOwnerTeams   []string
+	deprecated          bool
+	excludeDeprecated   bool
+	stabilityLevels     []string
+	excludeStability    []string
+	previewNames        []string
+	excludePreviewNames []string
+	sunsetFrom          string
+	sunsetTo            string
+	sunsetFromDate      *time.Time
+	sunsetToDate        *time.Time
+	matchAll            bool
 }
 
 func (o *Opts) Run() error {
@@ -45,9 +66,25 @@ func (o *Opts) Run() error {
 	}
 
 	criteria := &slice.Criteria{
-		OperationIDs: o.operationIDs,
-		Tags:         o.tags,
-		Paths:        o.paths,
+		OperationIDs:           o.operationIDs,
+		Tags:                   o.tags,
+		Paths:                  o.paths,
+		Methods:                o.methods,
+		OwnerTeams:             o.ownerTeams,
+		Deprecated:             o.deprecated,
+		StabilityLevels:        o.stabilityLevels,
+		PreviewNames:           o.previewNames,
+		SunsetFrom:             o.sunsetFromDate,
+		SunsetTo:               o.sunsetToDate,
+		ExcludeOperationIDs:    o.excludeOperationIDs,
+		ExcludeTags:            o.excludeTags,
+		ExcludePaths:           o.excludePaths,
+		ExcludeMethods:         o.excludeMethods,
+		ExcludeOwnerTeams:      o.excludeOwnerTeams,
+		ExcludeDeprecated:      o.excludeDeprecated,
+		ExcludeStabilityLevels: o.excludeStability,
+		ExcludePreviewNames:    o.excludePreviewNames,
+		MatchAll:               o.matchAll,
 	}
 
 	// Log what we're slicing
@@ -60,6 +97,51 @@ func (o *Opts) Run() error {
 	if len(criteria.Paths) > 0 {
 		log.Printf("Slicing operations by paths: %v", criteria.Paths)
 	}
+	if len(criteria.Methods) > 0 {
+		log.Printf("Slicing operations by methods: %v", criteria.Methods)
+	}
+	if len(criteria.ExcludeOperationIDs) > 0 {
+		log.Printf("Excluding operations by IDs: %v", criteria.ExcludeOperationIDs)
+	}
+	if len(criteria.ExcludeTags) > 0 {
+		log.Printf("Excluding operations by tags: %v", criteria.ExcludeTags)
+	}
+	if len(criteria.ExcludePaths) > 0 {
+		log.Printf("Excluding operations by paths: %v", criteria.ExcludePaths)
+	}
+	if len(criteria.ExcludeMethods) > 0 {
+		log.Printf("Excluding operations by methods: %v", criteria.ExcludeMethods)
+	}
+	if len(criteria.OwnerTeams) > 0 {
+		log.Printf("Slicing operations by owner teams: %v", criteria.OwnerTeams)
+	}
+	if criteria.Deprecated {
+		log.Printf("Slicing deprecated operations")
+	}
+	if len(criteria.StabilityLevels) > 0 {
+		log.Printf("Slicing operations by stability levels: %v", criteria.StabilityLevels)
+	}
+	if len(criteria.PreviewNames) > 0 {
+		log.Printf("Slicing operations by preview names: %v", criteria.PreviewNames)
+	}
+	if o.sunsetFrom != "" || o.sunsetTo != "" {
+		log.Printf("Slicing operations by sunset date range: [%q, %q]", o.sunsetFrom, o.sunsetTo)
+	}
+	if len(criteria.ExcludeOwnerTeams) > 0 {
+		log.Printf("Excluding operations by owner teams: %v", criteria.ExcludeOwnerTeams)
+	}
+	if criteria.ExcludeDeprecated {
+		log.Printf("Excluding deprecated operations")
+	}
+	if len(criteria.ExcludeStabilityLevels) > 0 {
+		log.Printf("Excluding operations by stability levels: %v", criteria.ExcludeStabilityLevels)
+	}
+	if len(criteria.ExcludePreviewNames) > 0 {
+		log.Printf("Excluding operations by preview names: %v", criteria.ExcludePreviewNames)
+	}
+	if criteria.MatchAll {
+		log.Printf("Selecting operations matching all the criteria")
+	}
 
 	// Slice the spec (includes automatic cleanup of unused tags and schemas)
 	if err := slice.Slice(specInfo.Spec, criteria); err != nil {
@@ -79,15 +161,66 @@ func (o *Opts) PreRunE(_ []string) error {
 		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
 	}
 
-	if len(o.operationIDs) == 0 && len(o.tags) == 0 && len(o.paths) == 0 {
-		return errors.New("at least one of --operation-ids, --tags, or --paths must be specified")
+	if !o.hasCriteria() {
+		return errors.New("at least one of --ids, --tags, --paths, --methods, --owner-teams, --deprecated, " +
+			"--stability-level, --preview-names, --sunset-from, --sunset-to or their --exclude-* variants must be specified")
+	}
+
+	if o.deprecated && o.excludeDeprecated {
+		return fmt.Errorf("--%s and --%s cannot be used together", flag.Deprecated, flag.ExcludeDeprecated)
+	}
+
+	for _, levels := range [][]string{o.stabilityLevels, o.excludeStability} {
+		for i, v := range levels {
+			levels[i] = strings.ToLower(v)
+			if err := apiversion.ValidateStabilityLevel(levels[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	if o.sunsetFromDate, err = parseDate(o.sunsetFrom); err != nil {
+		return err
+	}
+
+	if o.sunsetToDate, err = parseDate(o.sunsetTo); err != nil {
+		return err
 	}
 
 	return openapi.ValidateFormatAndOutput(o.format, o.outputPath)
 }
 
+func (o *Opts) hasCriteria() bool {
+	if o.deprecated || o.excludeDeprecated || o.sunsetFrom != "" || o.sunsetTo != "" {
+		return true
+	}
+
+	for _, values := range [][]string{
+		o.operationIDs, o.tags, o.paths, o.methods, o.ownerTeams, o.stabilityLevels, o.previewNames,
+		o.excludeOperationIDs, o.excludeTags, o.excludePaths, o.excludeMethods, o.excludeOwnerTeams, o.excludeStability, o.excludePreviewNames,
+	} {
+		if len(values) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
 // Builder builds the slice command with the following signature:
-// slice -s spec -o output.json --operation-ids "op1,op2" --tags "tag1,tag2" --paths "/api/v1".
+// slice -s spec -o output.json --ids "op1,op2" --tags "tag1,tag2" --paths "/api/v1" --methods "GET" --exclude-ids "op3" --all.
 func Builder() *cobra.Command {
 	opts := &Opts{
 		fs: afero.NewOsFs(),
@@ -95,19 +228,34 @@ func Builder() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "slice -s spec",
-		Short: "Slice a subset of an OpenAPI specification by operation IDs, tags, or paths",
+		Short: "Slice a subset of an OpenAPI specification by operation IDs, tags, paths, or HTTP methods",
 		Long: `Slice creates a valid mini OpenAPI specification containing only the operations
 that match the specified criteria. The output includes all necessary schemas and
-components referenced by the selected operations.
+components referenced by the selected operations. Unused components are removed and
+the command fails if the resulting specification contains dangling references.
 
 You can filter by:
   - Operation IDs: Specific operation identifiers
   - Tags: Operations tagged with specific values
   - Paths: Operations under specific path patterns
+  - Methods: Operations with specific HTTP methods
+  - Owner teams: Operations owned by specific teams (x-xgen-owner-team)
+  - Deprecated: Operations marked as deprecated
+  - Stability levels: Operations with content versions of specific stability levels
+  - Preview names: Operations with content versions in specific private previews (x-xgen-preview)
+  - Sunset dates: Operations with an x-sunset within a date range
+
+Paths are compared ignoring parameter names and support glob patterns, where '*' matches
+a single segment and '**' matches any number of segments, and regular expressions
+prefixed with 'regex:'.
+
+By default, operations matching any of the criteria are selected. Use --all to select
+only the operations matching all of them. Operations matching any of the --exclude-*
+criteria are always removed.
 
 Multiple values can be specified as comma-separated lists.`,
 		Example: `  # Slice specific operations by ID:
-  foascli slice -s spec.yaml -o subset.yaml --operation-ids "getUser,createUser"
+  foascli slice -s spec.yaml -o subset.yaml --ids "getUser,createUser"
 
   # Slice operations by tags:
   foascli slice -s spec.yaml -o subset.yaml --tags "Users,Authentication"
@@ -116,7 +264,13 @@ Multiple values can be specified as comma-separated lists.`,
   foascli slice -s spec.yaml -o subset.yaml --paths "/api/v1/users"
 
   # Combine multiple criteria:
-  foascli slice -s spec.yaml -o subset.yaml --tags "Users" --paths "/api/v1"`,
+  foascli slice -s spec.yaml -o subset.yaml --tags "Users" --paths "/api/v1"
+
+  # Slice the read operations of every cluster endpoint except one:
+  foascli slice -s spec.yaml -o subset.yaml --all --paths "/api/atlas/v2/groups/{}/clusters/**" --methods "GET" --exclude-ids "getClusterStatus"
+
+  # Slice every operation owned by a team that sunsets this quarter:
+  foascli slice -s spec.yaml -o subset.yaml --all --owner-teams "Atlas Dedicated" --sunset-from 2026-10-01 --sunset-to 2026-12-31`,
 		Args: cobra.NoArgs,
 		PreRunE: func(_ *cobra.Command, args []string) error {
 			return opts.PreRunE(args)
@@ -132,11 +286,33 @@ Multiple values can be specified as comma-separated lists.`,
 	cmd.Flags().StringSliceVar(&opts.operationIDs, flag.OperationIDs, []string{}, usage.OperationIDs)
 	cmd.Flags().StringSliceVar(&opts.tags, flag.Tags, []string{}, usage.Tags)
 	cmd.Flags().StringSliceVar(&opts.paths, flag.Paths, []string{}, usage.Paths)
+	cmd.Flags().StringSliceVar(&opts.methods, flag.Methods, []string{}, usage.Methods)
+	cmd.Flags().StringSliceVar(&opts.excludeOperationIDs, flag.ExcludeOperationIDs, []string{}, usage.ExcludeOperationIDs)
+	cmd.Flags().StringSliceVar(&opts.excludeTags, flag.ExcludeTags, []string{}, usage.ExcludeTags)
+	cmd.Flags().StringSliceVar(&opts.excludePaths, flag.ExcludePaths, []string{}, usage.ExcludePaths)
+	cmd.Flags().StringSliceVar(&opts.excludeMethods, flag.ExcludeMethods, []string{}, usage.ExcludeMethods)
+	cmd.Flags().StringSliceVar(&opts.ownerTeams, flag.OwnerTeams, []string{}, usage.OwnerTeams)
+	cmd.Flags().StringSliceVar(&opts.excludeOwnerTeams, flag.ExcludeOwnerTeams, []string{}, usage.ExcludeOwnerTeams)
+	cmd.Flags().BoolVar(&opts.deprecated, flag.Deprecated, false, usage.Deprecated)
+	cmd.Flags().BoolVar(&opts.excludeDeprecated, flag.ExcludeDeprecated, false, usage.ExcludeDeprecated)
+	cmd.Flags().StringSliceVar(&opts.stabilityLevels, flag.StabilityLevel, []string{}, usage.StabilityLevels)
+	cmd.Flags().StringSliceVar(&opts.excludeStability, flag.ExcludeStabilityLevel, []string{}, usage.ExcludeStability)
+	cmd.Flags().StringSliceVar(&opts.previewNames, flag.PreviewNames, []string{}, usage.PreviewNames)
+	cmd.Flags().StringSliceVar(&opts.excludePreviewNames, flag.ExcludePreviewNames, []string{}, usage.ExcludePreviewNames)
+	cmd.Flags().StringVar(&opts.sunsetFrom, flag.SunsetFrom, "", usage.SunsetFrom)
+	cmd.Flags().StringVar(&opts.sunsetTo, flag.SunsetTo, "", usage.SunsetTo)
+	cmd.Flags().BoolVar(&opts.matchAll, flag.MatchAll, false, usage.MatchAll)
 
 	// Required flags
 	_ = cmd.MarkFlagRequired(flag.Output)
 	_ = cmd.MarkFlagRequired(flag.Spec)
-	cmd.MarkFlagsOneRequired(flag.Tags, flag.Paths, flag.OperationIDs)
+	cmd.MarkFlagsOneRequired(
+		flag.Tags, flag.Paths, flag.OperationIDs, flag.Methods,
+		flag.OwnerTeams, flag.Deprecated, flag.StabilityLevel, flag.PreviewNames, flag.SunsetFrom, flag.SunsetTo,
+		flag.ExcludeTags, flag.ExcludePaths, flag.ExcludeOperationIDs, flag.ExcludeMethods,
+		flag.ExcludeOwnerTeams, flag.ExcludeDeprecated, flag.ExcludeStabilityLevel, flag.ExcludePreviewNames,
+	)
+	cmd.MarkFlagsMutuallyExclusive(flag.Deprecated, flag.ExcludeDeprecated)
 
 	return cmd
 }
@@ -58,6 +58,86 @@ func TestOpts_PreRunE(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "valid with exclusions only",
+			opts: Opts{
+				basePath:     "spec.yaml",
+				outputPath:   "output.yaml",
+				format:       "yaml",
+				excludeTags:  []string{"tag1"},
+				excludePaths: []string{"/api/v1/**"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "valid with methods and all",
+			opts: Opts{
+				basePath:   "spec.yaml",
+				outputPath: "output.yaml",
+				format:     "yaml",
+				methods:    []string{"GET"},
+				tags:       []string{"tag1"},
+				matchAll:   true,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "valid with owner teams and sunset range",
+			opts: Opts{
+				basePath:   "spec.yaml",
+				outputPath: "output.yaml",
+				format:     "yaml",
+				ownerTeams: []string{"Atlas Dedicated"},
+				sunsetFrom: "2026-10-01",
+				sunsetTo:   "2026-12-31",
+				matchAll:   true,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "valid with deprecated only",
+			opts: Opts{
+				basePath:   "spec.yaml",
+				outputPath: "output.yaml",
+				format:     "yaml",
+				deprecated: true,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid sunset date",
+			opts: Opts{
+				basePath:   "spec.yaml",
+				outputPath: "output.yaml",
+				format:     "yaml",
+				sunsetFrom: "01-10-2026",
+			},
+			wantErr:  require.Error,
+			errorMsg: "cannot parse",
+		},
+		{
+			name: "invalid stability level",
+			opts: Opts{
+				basePath:        "spec.yaml",
+				outputPath:      "output.yaml",
+				format:          "yaml",
+				stabilityLevels: []string{"BETA"},
+			},
+			wantErr:  require.Error,
+			errorMsg: "invalid stability level",
+		},
+		{
+			name: "deprecated and exclude deprecated",
+			opts: Opts{
+				basePath:          "spec.yaml",
+				outputPath:        "output.yaml",
+				format:            "yaml",
+				deprecated:        true,
+				excludeDeprecated: true,
+			},
+			wantErr:  require.Error,
+			errorMsg: "--deprecated and --exclude-deprecated cannot be used together",
+		},
 		{
 			name: "missing base path",
 			opts: Opts{
@@ -76,7 +156,7 @@ func TestOpts_PreRunE(t *testing.T) {
 				format:     "yaml",
 			},
 			wantErr:  require.Error,
-			errorMsg: "at least one of --operation-ids, --tags, or --paths must be specified",
+			errorMsg: "at least one of --ids, --tags, --paths, --methods, --owner-teams",
 		},
 	}
 
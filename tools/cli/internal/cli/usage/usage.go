@@ -41,6 +41,73 @@ const (
 	Version             = "Version of the API."
 	Tags                = "Comma-separated list of tags to extract."
 	OperationIDs        = "Comma-separated list of operation IDs to extract."
-	Paths               = "Comma-separated list of path patterns to extract."
+	Paths               = "Comma-separated list of path patterns to extract. Supports glob (e.g. /api/v2/groups/{}/**) and 'regex:' patterns."
 	KeepIPAExceptions   = "Keep x-xgen-IPA-exception extensions in the filtered output."
+	ExcludeOperationIDs = "Comma-separated list of operation IDs to exclude."
+	ExcludeTags         = "Comma-separated list of tags to exclude."
+	ExcludePaths        = "Comma-separated list of path patterns to exclude. Supports glob and 'regex:' patterns."
+	Methods             = "Comma-separated list of HTTP methods to extract."
+	ExcludeMethods      = "Comma-separated list of HTTP methods to exclude."
+	MatchAll            = "Select only the operations matching all the criteria instead of any of them."
+	OwnerTeams          = "Comma-separated list of owner teams (x-xgen-owner-team) to extract."
+	ExcludeOwnerTeams   = "Comma-separated list of owner teams (x-xgen-owner-team) to exclude."
+	Deprecated          = "Extract deprecated operations."
+	ExcludeDeprecated   = "Exclude deprecated operations."
+	StabilityLevels     = "Stability levels of the content versions to extract. Valid values: [STABLE, UPCOMING, PUBLIC-PREVIEW, PRIVATE-PREVIEW]"
+	ExcludeStability    = "Stability levels of the content versions to exclude. Valid values: [STABLE, UPCOMING, PUBLIC-PREVIEW, PRIVATE-PREVIEW]"
+	PreviewNames        = "Comma-separated list of private preview names (x-xgen-preview) to extract."
+	ExcludePreviewNames = "Comma-separated list of private preview names (x-xgen-preview) to exclude."
+	SunsetFrom          = "Extract operations with an x-sunset on or after this date. (Format: YYYY-MM-DD)"
+	SunsetTo            = "Extract operations with an x-sunset on or before this date. (Format: YYYY-MM-DD)"
+	GraphFormat         = "Output format. Supported values are 'dot', 'mermaid' or 'json'."
+	Schema              = "Name of the component schema, e.g. ClusterDescription20240805 or #/components/schemas/ClusterDescription20240805."
+	MatrixFormat        = "Output format. Supported values are 'csv', 'markdown' or 'json'."
+	Detailed            = "Output the stability level, release date, operation counts, sunset dates and environments of each version."
+	UpcomingVersion     = "Upcoming version to promote to stable. (Format: YYYY-MM-DD.upcoming)"
+	ScaffoldVersion     = "New stable version to scaffold. (Format: YYYY-MM-DD)"
+	RenderFormat        = "Output format. Supported values are 'markdown', 'html', 'atom' or 'rss'."
+	FeedTitle           = "Title of the Atom or RSS feed."
+	FeedLink            = "URL of the changelog page linked from the Atom or RSS feed."
+	NotificationTitle   = "Title of the notification."
+	IncludeHidden       = "Include the changes hidden from the changelog as spec corrections."
+	Ops                 = "File with the operation IDs called by the client, one per line."
+	PinnedVersion       = "API version pinned by the client, e.g. 2024-08-05."
+	Since               = "Date in the format YYYY-MM-DD of the oldest changes to include."
+	VersionDiffFolder   = "Folder with the version-diff/<from>_<to>.json files generated by the changelog create command."
+	MigrationFrom       = "API version to migrate from, e.g. 2023-02-01."
+	MigrationTo         = "API version to migrate to, e.g. 2025-03-12."
+	IncludePreviews     = "Generate the preview and upcoming changelogs in the preview and private-preview folders from the base folder's previews."
+	WebhookTemplate     = "Path to the Go template rendering each JSON webhook payload."
+	BatchSize           = "Maximum number of changes per payload."
+	Blocks              = "Use Slack Block Kit with a summary and the changes grouped by tag and operation."
+	MaxMessages         = "Maximum number of messages. The last message links to the full changelog. 0 means no limit."
+	ChangelogLink       = "URL of the full changelog."
+	QueryOperationIDs   = "Comma-separated list of operation IDs of the changes."
+	QueryTags           = "Comma-separated list of tags of the changes."
+	PathPrefix          = "Prefix of the paths of the changes."
+	QueryVersions       = "Comma-separated list of API versions of the changes. (Format: YYYY-MM-DD)"
+	ChangeCodes         = "Comma-separated list of change codes, e.g. response-property-removed."
+	BackwardCompatible  = "Only include the backward compatible changes if true, or the breaking changes if false."
+	Hidden              = "Only include the changes hidden from the changelog if true, or the visible changes if false."
+	QueryFormat         = "Output format. Supported values are 'json', 'table' or 'markdown'."
+	DigestFormat        = "Output format of the digests. Supported values are 'markdown' or 'json'."
+	DigestOutput        = "Folder where the command will store one digest per owner team."
+	OwnerTeamsSpec      = "Path to the raw OAS file with the x-xgen-owner-team of each operation, added to the changelog with the hidden changes."
+	ChangelogMetadata   = "Path to the changelog metadata file with the API versions."
+	SnapshotsDir        = "Folder with one snapshot subfolder per run date (YYYY-MM-DD), with the metadata.json and OAS files."
+	BaseSpec            = "Base spec file to compare against the revision spec file."
+	RevisionSpec        = "Revision spec file to compare against the base spec file."
+	Repo                = "Path to the local git repository."
+	FromRevision        = "Git revision of the base of the changelog, e.g. a release tag."
+	ToRevision          = "Git revision of the changelog."
+	SpecDir             = "Folder of the repository with the openapi-<version>.json and versions.json files."
+	ChangelogDir        = "Folder of the repository with the changelog.json and internal/metadata.json files."
+	MinSunsetDays       = "Minimum number of days between a version and the sunset of the version it supersedes."
+	SunsetOffset        = "Number of days after the new version when the previous version is sunset."
+	Environments        = "Comma-separated list of environments to consider. Valid values: [dev, qa, staging, prod]"
+	MessageRules        = "Path to the YAML file with the rewriting rules of the --language changelog messages. The default rules are used if not set."
+	MessageSamples      = "Path to the YAML file with the sample messages to run through the rules."
+	Message             = "Changelog message to run through the rules."
+	ChangeCode          = "Change code of the message, e.g. response-property-enum-value-added."
+	Language            = "Language of the changelog messages. Supported values are 'en', 'ru' or 'pt-br'. Each language has its own default rules."
 )
@@ -0,0 +1,174 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versions
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	csvFormat      = "csv"
+	markdownFormat = "markdown"
+	jsonFormat     = "json"
+
+	notAvailable = "-"
+)
+
+type MatrixOpts struct {
+	fs         afero.Fs
+	basePath   string
+	outputPath string
+	format     string
+	env        string
+}
+
+func (o *MatrixOpts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	matrix, err := openapi.NewVersionMatrix(specInfo.Spec, o.env)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := o.matrixAsBytes(matrix)
+	if err != nil {
+		return err
+	}
+
+	if o.outputPath != "" {
+		return afero.WriteFile(o.fs, o.outputPath, bytes, 0o600)
+	}
+
+	fmt.Println(string(bytes))
+	return nil
+}
+
+func (o *MatrixOpts) matrixAsBytes(matrix *openapi.VersionMatrix) ([]byte, error) {
+	switch o.format {
+	case csvFormat:
+		return matrixAsCSV(matrix)
+	case markdownFormat:
+		return matrixAsMarkdown(matrix), nil
+	default:
+		return json.MarshalIndent(matrix, "", "  ")
+	}
+}
+
+func matrixRows(matrix *openapi.VersionMatrix) [][]string {
+	header := append([]string{"operationId", "method", "path"}, matrix.Versions...)
+	rows := [][]string{header}
+
+	for _, operation := range matrix.Operations {
+		row := []string{operation.OperationID, operation.Method, operation.Path}
+		for _, version := range matrix.Versions {
+			status, ok := operation.Versions[version]
+			if !ok {
+				status = notAvailable
+			}
+			row = append(row, status)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func matrixAsCSV(matrix *openapi.VersionMatrix) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(matrixRows(matrix)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func matrixAsMarkdown(matrix *openapi.VersionMatrix) []byte {
+	rows := matrixRows(matrix)
+
+	var b strings.Builder
+	for i, row := range rows {
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		if i == 0 {
+			b.WriteString(strings.Repeat("| --- ", len(row)) + "|\n")
+		}
+	}
+
+	return []byte(b.String())
+}
+
+func (o *MatrixOpts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %q to include the base OAS", flag.Spec)
+	}
+
+	if o.format != csvFormat && o.format != markdownFormat && o.format != jsonFormat {
+		return fmt.Errorf("output format must be either 'csv', 'markdown' or 'json', got %q", o.format)
+	}
+
+	return nil
+}
+
+// MatrixBuilder builds the versions matrix command with the following signature:
+// versions matrix -s oas --env dev|qa|staging|prod -f csv|markdown|json.
+func MatrixBuilder() *cobra.Command {
+	opts := &MatrixOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "matrix -s spec",
+		Short: "Get the availability of each operation in each API version of an OpenAPI specification.",
+		Long: `Matrix outputs a table where the rows are the operations and the columns are the API versions.
+Each cell contains the status of the operation in the version:
+  - available: the operation is available in the version
+  - deprecated: the operation is deprecated
+  - sunsetting: the content of the version has a sunset date (x-sunset)
+  - preview: the operation is available in a public or private preview version
+  - upcoming: the operation is available in an upcoming version
+  - "-": the operation is not available in the version`,
+		Example: `  # Print the matrix of the prod versions as Markdown:
+  foascli versions matrix -s openapi-foas.json --env prod -f markdown`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVar(&opts.env, flag.Environment, "", usage.Environment)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, csvFormat, usage.MatrixFormat)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	return cmd
+}
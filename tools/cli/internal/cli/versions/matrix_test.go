@@ -0,0 +1,88 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versions
+
+import (
+	"testing"
+
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrix_Run(t *testing.T) {
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{format: "csv", expected: "operationId,method,path,2023-01-01,2023-02-01"},
+		{format: "markdown", expected: "| operationId | method | path | 2023-01-01 | 2023-02-01"},
+		{format: "json", expected: `"versions": [`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			opts := &MatrixOpts{
+				basePath:   "../../../test/data/base_spec.json",
+				outputPath: "matrix.out",
+				format:     tc.format,
+				env:        "prod",
+				fs:         fs,
+			}
+
+			require.NoError(t, opts.Run())
+			b, err := afero.ReadFile(fs, opts.outputPath)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.expected)
+		})
+	}
+}
+
+func TestMatrixAsMarkdown(t *testing.T) {
+	matrix := &openapi.VersionMatrix{
+		Versions: []string{"2023-01-01", "2024-01-01"},
+		Operations: []*openapi.OperationAvailability{
+			{
+				OperationID: "getCluster",
+				Method:      "GET",
+				Path:        "/clusters",
+				Versions:    map[string]string{"2024-01-01": openapi.AvailableStatus},
+			},
+		},
+	}
+
+	expected := `| operationId | method | path | 2023-01-01 | 2024-01-01 |
+| --- | --- | --- | --- | --- |
+| getCluster | GET | /clusters | - | available |
+`
+	assert.Equal(t, expected, string(matrixAsMarkdown(matrix)))
+
+	b, err := matrixAsCSV(matrix)
+	require.NoError(t, err)
+	assert.Equal(t, "operationId,method,path,2023-01-01,2024-01-01\ngetCluster,GET,/clusters,-,available\n", string(b))
+}
+
+func TestMatrix_PreRun(t *testing.T) {
+	opts := &MatrixOpts{basePath: "spec.json", format: "xml"}
+	require.ErrorContains(t, opts.PreRunE(nil), "output format must be either 'csv', 'markdown' or 'json'")
+
+	opts = &MatrixOpts{format: "csv"}
+	require.ErrorContains(t, opts.PreRunE(nil), "no OAS detected")
+
+	opts = &MatrixOpts{basePath: "spec.json", format: "markdown"}
+	require.NoError(t, opts.PreRunE(nil))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/flag"
+	"github.com/mongodb/openapi/tools/cli/internal/cli/usage"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/scaffold"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const defaultSunsetOffsetDays = 365
+
+type ScaffoldOpts struct {
+	fs           afero.Fs
+	basePath     string
+	outputPath   string
+	format       string
+	version      string
+	operationIDs []string
+	sunsetOffset int
+	versionDate  time.Time
+}
+
+func (o *ScaffoldOpts) Run() error {
+	loader := openapi.NewOpenAPI3()
+	specInfo, err := loader.CreateOpenAPISpecFromPath(o.basePath)
+	if err != nil {
+		return err
+	}
+
+	sunsetDate := o.versionDate.AddDate(0, 0, o.sunsetOffset)
+	operations, err := scaffold.Version(specInfo.Spec, o.version, o.operationIDs, sunsetDate)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(scaffoldSummary(o.version, sunsetDate, operations))
+	return openapi.Save(o.outputPath, specInfo.Spec, o.format, o.fs)
+}
+
+// scaffoldSummary returns the list of scaffolded operations with their new content types.
+func scaffoldSummary(version string, sunsetDate time.Time, operations []*scaffold.Operation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Scaffolded version %q for %d operations, previous versions sunset on %s:\n",
+		version, len(operations), sunsetDate.Format(time.DateOnly))
+	for _, operation := range operations {
+		fmt.Fprintf(&b, "  - %s %s (%s) from %s: %s\n",
+			operation.Method,
+			operation.Path,
+			operation.OperationID,
+			strings.Join(operation.PreviousVersions, ", "),
+			strings.Join(operation.ContentTypes, ", "))
+	}
+
+	return b.String()
+}
+
+func (o *ScaffoldOpts) PreRunE(_ []string) error {
+	if o.basePath == "" {
+		return fmt.Errorf("no OAS detected. Please, use the flag %s to include the base OAS", flag.Spec)
+	}
+
+	if len(o.operationIDs) == 0 {
+		return fmt.Errorf("at least one operation ID must be provided with the flag %s", flag.OperationIDs)
+	}
+
+	version, err := apiversion.New(apiversion.WithVersion(o.version))
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", o.version, err)
+	}
+	if !version.IsStable() {
+		return fmt.Errorf("version must be a stable version (YYYY-MM-DD), got %q", o.version)
+	}
+	o.versionDate = version.Date()
+
+	if o.sunsetOffset <= 0 {
+		return errors.New("sunset offset must be a positive number of days")
+	}
+
+	return openapi.ValidateFormatAndOutput(o.format, o.outputPath)
+}
+
+// ScaffoldBuilder builds the versions scaffold command with the following signature:
+// versions scaffold -s spec --version 2026-11-01 --ids getCluster,updateCluster --sunset-offset 365 -o spec.json.
+func ScaffoldBuilder() *cobra.Command {
+	opts := &ScaffoldOpts{
+		fs: afero.NewOsFs(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "scaffold -s spec --version YYYY-MM-DD --ids operationIds -o output",
+		Short: "Scaffold a new API version for the selected operations.",
+		Long: `Scaffold introduces a new API version for the selected operations. For each request body and response,
+the content types of the latest stable version are copied to the new version with the x-xgen-version
+extension set to the new version, and the previous content types are marked for sunset with an x-sunset
+set to the new version date plus the sunset offset. Existing x-sunset extensions are preserved.`,
+		Example: `  # Scaffold the 2026-11-01 version for two operations:
+  foascli version scaffold -s openapi-mms.json --version 2026-11-01 --ids getCluster,updateCluster -o openapi-mms.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			return opts.PreRunE(args)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.basePath, flag.Spec, flag.SpecShort, "", usage.Spec)
+	cmd.Flags().StringVar(&opts.version, flag.Version, "", usage.ScaffoldVersion)
+	cmd.Flags().StringSliceVar(&opts.operationIDs, flag.OperationIDs, []string{}, usage.OperationIDs)
+	cmd.Flags().IntVar(&opts.sunsetOffset, flag.SunsetOffset, defaultSunsetOffsetDays, usage.SunsetOffset)
+	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
+	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, openapi.JSON, usage.Format)
+
+	_ = cmd.MarkFlagRequired(flag.Spec)
+	_ = cmd.MarkFlagRequired(flag.Version)
+	_ = cmd.MarkFlagRequired(flag.OperationIDs)
+	_ = cmd.MarkFlagRequired(flag.Output)
+	return cmd
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/scaffold"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffold_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &ScaffoldOpts{
+		basePath:     "../../../test/data/base_spec.json",
+		outputPath:   "scaffolded.json",
+		format:       openapi.JSON,
+		version:      "2026-11-01",
+		operationIDs: []string{"listProjects", "createProject"},
+		sunsetOffset: defaultSunsetOffsetDays,
+		versionDate:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		fs:           fs,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "application/vnd.atlas.2026-11-01+json")
+	assert.Contains(t, string(b), `"x-sunset": "2027-11-01"`)
+
+	spec, err := openapi3.NewLoader().LoadFromData(b)
+	require.NoError(t, err)
+
+	versions, err := openapi.ExtractVersionsWithEnv(spec, "")
+	require.NoError(t, err)
+	assert.Contains(t, versions, "2026-11-01")
+}
+
+func TestScaffold_Summary(t *testing.T) {
+	operations := []*scaffold.Operation{
+		{
+			OperationID:      "getCluster",
+			Method:           "GET",
+			Path:             "/clusters",
+			PreviousVersions: []string{"2024-08-05"},
+			ContentTypes:     []string{"application/vnd.atlas.2026-11-01+json"},
+		},
+	}
+
+	expected := `Scaffolded version "2026-11-01" for 1 operations, previous versions sunset on 2027-11-01:
+  - GET /clusters (getCluster) from 2024-08-05: application/vnd.atlas.2026-11-01+json
+`
+	assert.Equal(t, expected, scaffoldSummary("2026-11-01", time.Date(2027, 11, 1, 0, 0, 0, 0, time.UTC), operations))
+}
+
+func TestScaffold_PreRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        *ScaffoldOpts
+		expectedErr string
+	}{
+		{
+			name: "missing spec",
+			opts: &ScaffoldOpts{
+				version: "2026-11-01", operationIDs: []string{"getCluster"}, sunsetOffset: 365, format: "json", outputPath: "spec.json",
+			},
+			expectedErr: "no OAS detected",
+		},
+		{
+			name:        "missing operation IDs",
+			opts:        &ScaffoldOpts{basePath: "spec.json", version: "2026-11-01", sunsetOffset: 365, format: "json", outputPath: "spec.json"},
+			expectedErr: "at least one operation ID must be provided",
+		},
+		{
+			name: "upcoming version",
+			opts: &ScaffoldOpts{
+				basePath: "spec.json", version: "2026-11-01.upcoming", operationIDs: []string{"getCluster"}, sunsetOffset: 365, format: "json",
+				outputPath: "spec.json",
+			},
+			expectedErr: "version must be a stable version",
+		},
+		{
+			name: "invalid sunset offset",
+			opts: &ScaffoldOpts{
+				basePath: "spec.json", version: "2026-11-01", operationIDs: []string{"getCluster"}, format: "json", outputPath: "spec.json",
+			},
+			expectedErr: "sunset offset must be a positive number of days",
+		},
+		{
+			name: "valid",
+			opts: &ScaffoldOpts{
+				basePath: "spec.json", version: "2026-11-01", operationIDs: []string{"getCluster"}, sunsetOffset: 365, format: "json",
+				outputPath: "spec.json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.PreRunE(nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
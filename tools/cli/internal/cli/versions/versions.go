@@ -29,6 +29,9 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// environments are the environments used to report the visibility of each version.
+var environments = []string{"dev", "qa", "staging", "prod"}
+
 type Opts struct {
 	fs             afero.Fs
 	basePath       string
@@ -36,6 +39,7 @@ type Opts struct {
 	format         string
 	env            string
 	stabilityLevel []string
+	detailed       bool
 }
 
 func (o *Opts) Run() error {
@@ -56,7 +60,16 @@ func (o *Opts) Run() error {
 	}
 
 	versions = o.filterStabilityLevelVersions(versions)
-	bytes, err := o.versionsAsBytes(versions)
+
+	var output any = versions
+	if o.detailed {
+		output, err = openapi.NewVersionDetails(specInfo.Spec, versions, environments)
+		if err != nil {
+			return err
+		}
+	}
+
+	bytes, err := o.versionsAsBytes(output)
 	if err != nil {
 		return err
 	}
@@ -101,7 +114,7 @@ func (o *Opts) filterStabilityLevelVersions(apiVersions []string) []string {
 	return out
 }
 
-func (o *Opts) versionsAsBytes(versions []string) ([]byte, error) {
+func (o *Opts) versionsAsBytes(versions any) ([]byte, error) {
 	data, err := json.MarshalIndent(versions, "", "  ")
 	if err != nil {
 		return nil, err
@@ -149,7 +162,7 @@ func (o *Opts) PreRunE(_ []string) error {
 }
 
 // Builder builds the versions command with the following signature:
-// versions -s oas --env dev|qa|staging|prod -stability-level STABLE|UPCOMING|PREVIEW.
+// versions -s oas --env dev|qa|staging|prod -stability-level STABLE|UPCOMING|PREVIEW --detailed.
 func Builder() *cobra.Command {
 	opts := &Opts{
 		fs: afero.NewOsFs(),
@@ -157,7 +170,7 @@ func Builder() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:     "versions -s spec ",
-		Aliases: []string{"versions list", "versions ls"},
+		Aliases: []string{"version", "versions list", "versions ls"},
 		Short:   "Get a list of versions from an OpenAPI specification.",
 		Args:    cobra.NoArgs,
 		PreRunE: func(_ *cobra.Command, args []string) error {
@@ -173,5 +186,8 @@ func Builder() *cobra.Command {
 	cmd.Flags().StringArrayVar(&opts.stabilityLevel, flag.StabilityLevel, nil, usage.StabilityLevel)
 	cmd.Flags().StringVarP(&opts.outputPath, flag.Output, flag.OutputShort, "", usage.Output)
 	cmd.Flags().StringVarP(&opts.format, flag.Format, flag.FormatShort, "json", usage.Format)
+	cmd.Flags().BoolVar(&opts.detailed, flag.Detailed, false, usage.Detailed)
+
+	cmd.AddCommand(MatrixBuilder(), ScaffoldBuilder())
 	return cmd
 }
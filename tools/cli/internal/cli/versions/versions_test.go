@@ -252,3 +252,25 @@ func TestVersion_PreRun(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestVersion_RunDetailed(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	opts := &Opts{
+		basePath:   "../../../test/data/base_spec.json",
+		outputPath: "foas.json",
+		fs:         fs,
+		env:        "prod",
+		format:     "json",
+		detailed:   true,
+	}
+
+	require.NoError(t, opts.Run())
+	b, err := afero.ReadFile(fs, opts.outputPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(b), `"version": "2023-02-01"`)
+	assert.Contains(t, string(b), `"stabilityLevel": "stable"`)
+	assert.Contains(t, string(b), `"releaseDate": "2023-02-01"`)
+	assert.Contains(t, string(b), `"operationsIntroduced"`)
+	assert.Contains(t, string(b), `"environments": [`)
+}
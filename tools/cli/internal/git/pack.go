@@ -0,0 +1,280 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	idxHeaderSize  = 8
+	idxFanoutSize  = 256 * 4
+	largeOffsetBit = 0x80000000
+	// copyMaxSize is the size of a delta copy instruction without size bytes
+	copyMaxSize = 0x10000
+)
+
+var idxMagic = []byte{0xff, 't', 'O', 'c'}
+
+// pack is a packfile with its version 2 index.
+type pack struct {
+	path string
+	// hashes are the sorted object names, with the offset of the object in the packfile at the same index
+	hashes  []Hash
+	offsets []int64
+}
+
+func openPacks(folder string) ([]*pack, error) {
+	idxPaths, err := filepath.Glob(filepath.Join(folder, "*.idx"))
+	if err != nil {
+		return nil, err
+	}
+
+	packs := make([]*pack, 0, len(idxPaths))
+	for _, idxPath := range idxPaths {
+		p, err := openPack(idxPath)
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, p)
+	}
+	return packs, nil
+}
+
+// openPack reads the index of a packfile:
+// header, fanout table, sorted object names, CRCs, 4 bytes offsets and 8 bytes offsets for large packfiles.
+func openPack(idxPath string) (*pack, error) {
+	idx, err := os.ReadFile(idxPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(idx) < idxHeaderSize+idxFanoutSize || !bytes.Equal(idx[:4], idxMagic) || binary.BigEndian.Uint32(idx[4:8]) != 2 {
+		return nil, fmt.Errorf("unsupported pack index %s, only version 2 is supported", idxPath)
+	}
+
+	count := int(binary.BigEndian.Uint32(idx[idxHeaderSize+idxFanoutSize-4:]))
+	hashesStart := idxHeaderSize + idxFanoutSize
+	offsetsStart := hashesStart + count*hashSize + count*4
+	largeOffsetsStart := offsetsStart + count*4
+	if len(idx) < largeOffsetsStart {
+		return nil, fmt.Errorf("invalid pack index %s", idxPath)
+	}
+
+	p := &pack{
+		path:    strings.TrimSuffix(idxPath, ".idx") + ".pack",
+		hashes:  make([]Hash, count),
+		offsets: make([]int64, count),
+	}
+	for i := range count {
+		copy(p.hashes[i][:], idx[hashesStart+i*hashSize:])
+
+		offset := binary.BigEndian.Uint32(idx[offsetsStart+i*4:])
+		if offset&largeOffsetBit == 0 {
+			p.offsets[i] = int64(offset)
+			continue
+		}
+
+		largeOffset := largeOffsetsStart + int(offset&^largeOffsetBit)*8
+		if len(idx) < largeOffset+8 {
+			return nil, fmt.Errorf("invalid pack index %s", idxPath)
+		}
+		p.offsets[i] = int64(binary.BigEndian.Uint64(idx[largeOffset:])) //nolint:gosec // offsets of packfiles fit in int64
+	}
+
+	return p, nil
+}
+
+func (p *pack) find(hash Hash) (int64, bool) {
+	i := sort.Search(len(p.hashes), func(i int) bool {
+		return bytes.Compare(p.hashes[i][:], hash[:]) >= 0
+	})
+	if i < len(p.hashes) && p.hashes[i] == hash {
+		return p.offsets[i], true
+	}
+	return 0, false
+}
+
+func (p *pack) readObject(r *Repository, offset int64) (objectType, []byte, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	return p.readObjectAt(r, f, offset)
+}
+
+// readObjectAt reads the object at the offset of the packfile, applying the deltas to their base objects.
+func (p *pack) readObjectAt(r *Repository, f *os.File, offset int64) (objectType, []byte, error) {
+	br := bufio.NewReader(io.NewSectionReader(f, offset, math.MaxInt64-offset))
+
+	// the header is the type and the variable length size of the inflated object
+	b, err := br.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	typ := objectType((b >> 4) & 0x07)
+	size := uint64(b & 0x0f)
+	for shift := 4; b&0x80 != 0; shift += 7 {
+		if b, err = br.ReadByte(); err != nil {
+			return 0, nil, err
+		}
+		size |= uint64(b&0x7f) << shift
+	}
+
+	switch typ {
+	case commitObject, treeObject, blobObject, tagObject:
+		data, err := inflate(br, size)
+		return typ, data, err
+	case ofsDeltaObject:
+		baseOffset, err := readBaseOffset(br)
+		if err != nil {
+			return 0, nil, err
+		}
+		delta, err := inflate(br, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		baseType, base, err := p.readObjectAt(r, f, offset-baseOffset)
+		if err != nil {
+			return 0, nil, err
+		}
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+	case refDeltaObject:
+		var baseHash Hash
+		if _, err := io.ReadFull(br, baseHash[:]); err != nil {
+			return 0, nil, err
+		}
+		delta, err := inflate(br, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		baseType, base, err := r.readObject(baseHash)
+		if err != nil {
+			return 0, nil, err
+		}
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+	}
+
+	return 0, nil, fmt.Errorf("invalid object type %d at offset %d of %s", typ, offset, p.path)
+}
+
+// readBaseOffset reads the negative offset of the base object of an offset delta.
+func readBaseOffset(br io.ByteReader) (int64, error) {
+	b, err := br.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+
+	offset := int64(b & 0x7f)
+	for b&0x80 != 0 {
+		if b, err = br.ReadByte(); err != nil {
+			return 0, err
+		}
+		offset = ((offset + 1) << 7) | int64(b&0x7f)
+	}
+	return offset, nil
+}
+
+func inflate(r io.Reader, size uint64) ([]byte, error) {
+	zr, err := zlib.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(zr, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+var errInvalidDelta = errors.New("invalid delta")
+
+// applyDelta applies the delta instructions to the base object. The delta starts with the base and result sizes,
+// followed by instructions to copy a range of the base object or to insert the next bytes of the delta.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	baseSize, n := binary.Uvarint(delta)
+	if n <= 0 || baseSize != uint64(len(base)) {
+		return nil, errInvalidDelta
+	}
+	delta = delta[n:]
+
+	resultSize, n := binary.Uvarint(delta)
+	if n <= 0 {
+		return nil, errInvalidDelta
+	}
+	delta = delta[n:]
+
+	result := make([]byte, 0, resultSize)
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+
+		if op&0x80 == 0 {
+			// insert the next op bytes of the delta, 0 is reserved
+			if op == 0 || int(op) > len(delta) {
+				return nil, errInvalidDelta
+			}
+			result = append(result, delta[:op]...)
+			delta = delta[op:]
+			continue
+		}
+
+		// copy from the base, the bits 0-3 flag the offset bytes and the bits 4-6 the size bytes
+		var offset, size uint64
+		for i := range 7 {
+			if op&(1<<i) == 0 {
+				continue
+			}
+			if len(delta) == 0 {
+				return nil, errInvalidDelta
+			}
+			if i < 4 {
+				offset |= uint64(delta[0]) << (8 * i)
+			} else {
+				size |= uint64(delta[0]) << (8 * (i - 4))
+			}
+			delta = delta[1:]
+		}
+		if size == 0 {
+			size = copyMaxSize
+		}
+		if offset+size > uint64(len(base)) {
+			return nil, errInvalidDelta
+		}
+		result = append(result, base[offset:offset+size]...)
+	}
+
+	if uint64(len(result)) != resultSize {
+		return nil, errInvalidDelta
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDelta(t *testing.T) {
+	base := []byte("hello world")
+	delta := []byte{
+		11, 17, // base and result sizes
+		0x80 | 0x10, 5, // copy 5 bytes from offset 0
+		6, ' ', 't', 'h', 'e', 'r', 'e', // insert 6 bytes
+		0x80 | 0x01 | 0x10, 5, 6, // copy 6 bytes from offset 5
+	}
+
+	result, err := applyDelta(base, delta)
+	require.NoError(t, err)
+	assert.Equal(t, "hello there world", string(result))
+}
+
+func TestApplyDelta_Invalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		delta []byte
+	}{
+		{name: "wrong base size", delta: []byte{10, 5, 0x90, 5}},
+		{name: "wrong result size", delta: []byte{11, 6, 0x90, 5}},
+		{name: "copy out of the base", delta: []byte{11, 5, 0x91, 10, 5}},
+		{name: "reserved instruction", delta: []byte{11, 0, 0}},
+		{name: "truncated insert", delta: []byte{11, 5, 5, 'a'}},
+		{name: "truncated copy", delta: []byte{11, 5, 0x91}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := applyDelta([]byte("hello world"), tc.delta)
+			require.ErrorIs(t, err, errInvalidDelta)
+		})
+	}
+}
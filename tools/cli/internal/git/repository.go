@@ -0,0 +1,352 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package git reads the files of the commits of a local git repository.
+// It reads the loose and packed objects directly, without the git binary or network access.
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const hashSize = 20
+
+// ErrNotFound is returned when a revision, object or file doesn't exist in the repository.
+var ErrNotFound = errors.New("not found")
+
+// Hash is the SHA-1 name of a git object.
+type Hash [hashSize]byte
+
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+type objectType int
+
+const (
+	commitObject   objectType = 1
+	treeObject     objectType = 2
+	blobObject     objectType = 3
+	tagObject      objectType = 4
+	ofsDeltaObject objectType = 6
+	refDeltaObject objectType = 7
+)
+
+var objectTypes = map[string]objectType{
+	"commit": commitObject,
+	"tree":   treeObject,
+	"blob":   blobObject,
+	"tag":    tagObject,
+}
+
+// Repository is a local git repository.
+type Repository struct {
+	// gitDir holds the HEAD of the working tree
+	gitDir string
+	// commonDir holds the objects and refs, it is different from gitDir for linked working trees
+	commonDir string
+	packs     []*pack
+}
+
+// Open opens the git repository of the working tree or bare repository at path.
+func Open(path string) (*Repository, error) {
+	gitDir, err := findGitDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	commonDir := gitDir
+	if contents, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = strings.TrimSpace(string(contents))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+	}
+
+	packs, err := openPacks(filepath.Join(commonDir, "objects", "pack"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{gitDir: gitDir, commonDir: commonDir, packs: packs}, nil
+}
+
+// findGitDir returns the .git folder of the working tree, the folder referenced by a .git file, or the bare repository.
+func findGitDir(path string) (string, error) {
+	dotGit := filepath.Join(path, ".git")
+	info, err := os.Stat(dotGit)
+	switch {
+	case err == nil && info.IsDir():
+		return dotGit, nil
+	case err == nil:
+		contents, err := os.ReadFile(dotGit)
+		if err != nil {
+			return "", err
+		}
+
+		gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(contents)), "gitdir: ")
+		if !ok {
+			return "", fmt.Errorf("invalid .git file %s", dotGit)
+		}
+		if !filepath.IsAbs(gitDir) {
+			gitDir = filepath.Join(path, gitDir)
+		}
+		return gitDir, nil
+	}
+
+	if _, err := os.Stat(filepath.Join(path, "HEAD")); err == nil {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("%s is not a git repository", path)
+}
+
+// ReadFile returns the contents of the file at the slash-separated path in the commit.
+func (r *Repository) ReadFile(commit Hash, path string) ([]byte, error) {
+	hash, typ, err := r.findPath(commit, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if typ != blobObject {
+		return nil, fmt.Errorf("%s is not a file", path)
+	}
+
+	_, contents, err := r.readObject(hash)
+	return contents, err
+}
+
+// ReadDir returns the names of the files in the folder at the slash-separated path in the commit, sorted by name.
+// Subfolders are not included.
+func (r *Repository) ReadDir(commit Hash, path string) ([]string, error) {
+	hash, typ, err := r.findPath(commit, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if typ != treeObject {
+		return nil, fmt.Errorf("%s is not a folder", path)
+	}
+
+	entries, err := r.readTree(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.isDir() {
+			names = append(names, entry.name)
+		}
+	}
+	return names, nil
+}
+
+// CommitTime returns the committer date of the commit.
+func (r *Repository) CommitTime(commit Hash) (time.Time, error) {
+	c, err := r.readCommit(commit)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return c.committerTime, nil
+}
+
+type commitInfo struct {
+	tree          Hash
+	parents       []Hash
+	committerTime time.Time
+}
+
+func (r *Repository) readCommit(hash Hash) (*commitInfo, error) {
+	typ, contents, err := r.readObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	if typ != commitObject {
+		return nil, fmt.Errorf("object %s is not a commit", hash)
+	}
+
+	c := &commitInfo{}
+	header, _, _ := bytes.Cut(contents, []byte("\n\n"))
+	for line := range strings.SplitSeq(string(header), "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "tree":
+			if c.tree, err = parseHash(value); err != nil {
+				return nil, err
+			}
+		case "parent":
+			parent, err := parseHash(value)
+			if err != nil {
+				return nil, err
+			}
+			c.parents = append(c.parents, parent)
+		case "committer":
+			// committer Name <email> 1700000000 +0000
+			fields := strings.Fields(value)
+			if len(fields) >= 2 {
+				if seconds, err := strconv.ParseInt(fields[len(fields)-2], 10, 64); err == nil {
+					c.committerTime = time.Unix(seconds, 0).UTC()
+				}
+			}
+		}
+	}
+
+	return c, nil
+}
+
+type treeEntry struct {
+	mode string
+	name string
+	hash Hash
+}
+
+func (e *treeEntry) isDir() bool {
+	return e.mode == "40000"
+}
+
+func (r *Repository) readTree(hash Hash) ([]*treeEntry, error) {
+	typ, contents, err := r.readObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	if typ != treeObject {
+		return nil, fmt.Errorf("object %s is not a tree", hash)
+	}
+
+	// each entry is "<mode> <name>\x00<20 bytes hash>"
+	var entries []*treeEntry
+	for len(contents) > 0 {
+		mode, rest, ok := bytes.Cut(contents, []byte(" "))
+		if !ok {
+			return nil, fmt.Errorf("invalid tree %s", hash)
+		}
+		name, rest, ok := bytes.Cut(rest, []byte{0})
+		if !ok || len(rest) < hashSize {
+			return nil, fmt.Errorf("invalid tree %s", hash)
+		}
+
+		entry := &treeEntry{mode: string(mode), name: string(name)}
+		copy(entry.hash[:], rest[:hashSize])
+		entries = append(entries, entry)
+		contents = rest[hashSize:]
+	}
+
+	return entries, nil
+}
+
+// findPath returns the hash and type of the object at the slash-separated path in the commit.
+func (r *Repository) findPath(commit Hash, path string) (Hash, objectType, error) {
+	c, err := r.readCommit(commit)
+	if err != nil {
+		return Hash{}, 0, err
+	}
+
+	hash, typ := c.tree, treeObject
+	for name := range strings.SplitSeq(strings.Trim(path, "/"), "/") {
+		if name == "" || name == "." {
+			continue
+		}
+		if typ != treeObject {
+			return Hash{}, 0, fmt.Errorf("%s: %w", path, ErrNotFound)
+		}
+
+		entries, err := r.readTree(hash)
+		if err != nil {
+			return Hash{}, 0, err
+		}
+
+		found := false
+		for _, entry := range entries {
+			if entry.name == name {
+				hash, typ, found = entry.hash, blobObject, true
+				if entry.isDir() {
+					typ = treeObject
+				}
+				break
+			}
+		}
+		if !found {
+			return Hash{}, 0, fmt.Errorf("%s: %w", path, ErrNotFound)
+		}
+	}
+
+	return hash, typ, nil
+}
+
+// readObject returns the type and contents of a loose or packed object.
+func (r *Repository) readObject(hash Hash) (objectType, []byte, error) {
+	name := hash.String()
+	f, err := os.Open(filepath.Join(r.commonDir, "objects", name[:2], name[2:]))
+	if err == nil {
+		defer f.Close()
+		return readLooseObject(f)
+	}
+
+	for _, p := range r.packs {
+		if offset, ok := p.find(hash); ok {
+			return p.readObject(r, offset)
+		}
+	}
+
+	return 0, nil, fmt.Errorf("object %s: %w", hash, ErrNotFound)
+}
+
+// readLooseObject reads a zlib compressed "<type> <size>\x00<contents>" object.
+func readLooseObject(f io.Reader) (objectType, []byte, error) {
+	zr, err := zlib.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	header, contents, ok := bytes.Cut(data, []byte{0})
+	if !ok {
+		return 0, nil, errors.New("invalid loose object")
+	}
+
+	name, size, _ := strings.Cut(string(header), " ")
+	typ, ok := objectTypes[name]
+	if !ok || size != strconv.Itoa(len(contents)) {
+		return 0, nil, fmt.Errorf("invalid loose object header %q", header)
+	}
+
+	return typ, contents, nil
+}
+
+func parseHash(value string) (Hash, error) {
+	var hash Hash
+	b, err := hex.DecodeString(value)
+	if err != nil || len(b) != hashSize {
+		return hash, fmt.Errorf("invalid object name %q", value)
+	}
+	copy(hash[:], b)
+	return hash, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepository creates a repository with two commits, an annotated tag on the first commit and a branch.
+func newTestRepository(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	path := t.TempDir()
+	runGit(t, path, "init", "-q", "-b", "main")
+
+	// large specs with small changes, so that git gc stores the second version as a delta
+	var spec strings.Builder
+	for i := range 500 {
+		fmt.Fprintf(&spec, "{\"operationId\": \"operation%d\"}\n", i)
+	}
+	writeFile(t, path, "openapi/v2/openapi-2023-01-01.json", spec.String())
+	writeFile(t, path, "openapi/v2/versions.json", `["2023-01-01"]`)
+	runGit(t, path, "add", "-A")
+	runGit(t, path, "commit", "-q", "-m", "first")
+	runGit(t, path, "tag", "-a", "v1", "-m", "release v1")
+
+	writeFile(t, path, "openapi/v2/openapi-2023-01-01.json", spec.String()+"{\"operationId\": \"newOperation\"}\n")
+	writeFile(t, path, "openapi/v2/openapi-2024-08-05.json", spec.String())
+	writeFile(t, path, "openapi/v2/versions.json", `["2023-01-01", "2024-08-05"]`)
+	runGit(t, path, "add", "-A")
+	runGit(t, path, "commit", "-q", "-m", "second")
+	runGit(t, path, "branch", "release")
+
+	return path
+}
+
+func runGit(t *testing.T, path string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = path
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_AUTHOR_DATE=2025-03-01T10:00:00Z",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com", "GIT_COMMITTER_DATE=2025-03-01T10:00:00Z",
+		"GIT_CONFIG_NOSYSTEM=1", "HOME="+path,
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func writeFile(t *testing.T, root, path, contents string) {
+	t.Helper()
+	path = filepath.Join(root, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+}
+
+func TestRepository(t *testing.T) {
+	path := newTestRepository(t)
+
+	// the objects are loose after the commits, and packed with deltas after git gc
+	for _, stage := range []string{"loose", "packed"} {
+		t.Run(stage, func(t *testing.T) {
+			if stage == "packed" {
+				runGit(t, path, "gc", "-q", "--aggressive")
+			}
+
+			repo, err := Open(path)
+			require.NoError(t, err)
+
+			for _, revision := range []string{"HEAD", "main", "release", "refs/heads/main", "v1", "HEAD~1", "main^", "HEAD^1~0", "HEAD^0"} {
+				hash, err := repo.ResolveRevision(revision)
+				require.NoError(t, err, revision)
+				assert.Equal(t, runGit(t, path, "rev-parse", revision+"^{commit}"), hash.String(), revision)
+			}
+
+			head, err := repo.ResolveRevision("HEAD")
+			require.NoError(t, err)
+			abbreviated, err := repo.ResolveRevision(head.String()[:10])
+			require.NoError(t, err)
+			assert.Equal(t, head, abbreviated)
+
+			contents, err := repo.ReadFile(head, "openapi/v2/openapi-2023-01-01.json")
+			require.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join(path, "openapi/v2/openapi-2023-01-01.json"))
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(contents))
+
+			first, err := repo.ResolveRevision("v1")
+			require.NoError(t, err)
+			contents, err = repo.ReadFile(first, "openapi/v2/versions.json")
+			require.NoError(t, err)
+			assert.JSONEq(t, `["2023-01-01"]`, string(contents))
+
+			names, err := repo.ReadDir(head, "openapi/v2")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"openapi-2023-01-01.json", "openapi-2024-08-05.json", "versions.json"}, names)
+
+			names, err = repo.ReadDir(first, "openapi/v2/")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"openapi-2023-01-01.json", "versions.json"}, names)
+
+			commitTime, err := repo.CommitTime(head)
+			require.NoError(t, err)
+			assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), commitTime)
+		})
+	}
+}
+
+func TestRepository_NotFound(t *testing.T) {
+	path := newTestRepository(t)
+	repo, err := Open(path)
+	require.NoError(t, err)
+
+	for _, revision := range []string{"unknown", "HEAD~2", "HEAD^2", "config", "0000000000"} {
+		_, err := repo.ResolveRevision(revision)
+		require.Error(t, err, revision)
+		assert.True(t, errors.Is(err, ErrNotFound), "%s: %v", revision, err)
+	}
+
+	head, err := repo.ResolveRevision("HEAD")
+	require.NoError(t, err)
+
+	_, err = repo.ReadFile(head, "openapi/v2/openapi-2025-01-01.json")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = repo.ReadFile(head, "openapi/v2/versions.json/file")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = repo.ReadFile(head, "openapi/v2")
+	require.EqualError(t, err, "openapi/v2 is not a file")
+
+	_, err = Open(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestOpen_LinkedWorkingTree(t *testing.T) {
+	path := newTestRepository(t)
+	worktree := filepath.Join(t.TempDir(), "worktree")
+	runGit(t, path, "worktree", "add", "-q", worktree, "v1")
+
+	repo, err := Open(worktree)
+	require.NoError(t, err)
+
+	head, err := repo.ResolveRevision("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, runGit(t, path, "rev-parse", "v1^{commit}"), head.String())
+
+	main, err := repo.ResolveRevision("main")
+	require.NoError(t, err)
+	assert.Equal(t, runGit(t, path, "rev-parse", "main"), main.String())
+}
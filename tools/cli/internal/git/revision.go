@@ -0,0 +1,234 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxSymbolicRefDepth = 10
+	minAbbreviatedHash  = 4
+)
+
+// ResolveRevision returns the commit of the revision. Supported revisions are HEAD, branches, tags, remote branches,
+// full or abbreviated commit hashes, followed by any number of ~N (Nth first-parent ancestor) and ^N (Nth parent) suffixes.
+// Annotated tags are resolved to the commit they point to.
+func (r *Repository) ResolveRevision(revision string) (Hash, error) {
+	name, suffixes := revision, ""
+	if i := strings.IndexAny(revision, "~^"); i >= 0 {
+		name, suffixes = revision[:i], revision[i:]
+	}
+
+	hash, err := r.resolveName(name)
+	if err != nil {
+		return Hash{}, fmt.Errorf("revision %s: %w", revision, err)
+	}
+
+	if hash, err = r.peelToCommit(hash); err != nil {
+		return Hash{}, err
+	}
+
+	for suffixes != "" {
+		op := suffixes[0]
+		end := 1
+		for end < len(suffixes) && suffixes[end] >= '0' && suffixes[end] <= '9' {
+			end++
+		}
+
+		n := 1
+		if end > 1 {
+			if n, err = strconv.Atoi(suffixes[1:end]); err != nil {
+				return Hash{}, fmt.Errorf("invalid revision %s", revision)
+			}
+		}
+		suffixes = suffixes[end:]
+
+		if hash, err = r.ancestor(hash, op, n); err != nil {
+			return Hash{}, fmt.Errorf("revision %s: %w", revision, err)
+		}
+	}
+
+	return hash, nil
+}
+
+// ancestor returns the Nth first-parent ancestor for ~N, or the Nth parent for ^N.
+func (r *Repository) ancestor(hash Hash, op byte, n int) (Hash, error) {
+	if op == '^' {
+		if n == 0 {
+			return hash, nil
+		}
+
+		c, err := r.readCommit(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+		if n > len(c.parents) {
+			return Hash{}, fmt.Errorf("commit %s has no parent %d: %w", hash, n, ErrNotFound)
+		}
+		return c.parents[n-1], nil
+	}
+
+	for range n {
+		c, err := r.readCommit(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+		if len(c.parents) == 0 {
+			return Hash{}, fmt.Errorf("commit %s has no parent: %w", hash, ErrNotFound)
+		}
+		hash = c.parents[0]
+	}
+	return hash, nil
+}
+
+// resolveName resolves a ref name with the same precedence as git, or an object name.
+func (r *Repository) resolveName(name string) (Hash, error) {
+	refs := []string{
+		name,
+		"refs/" + name,
+		"refs/tags/" + name,
+		"refs/heads/" + name,
+		"refs/remotes/" + name,
+		"refs/remotes/" + name + "/HEAD",
+	}
+	for _, ref := range refs {
+		// files of the git folder that are not refs, such as config, are skipped
+		if hash, err := r.resolveRef(ref); err == nil {
+			return hash, nil
+		}
+	}
+
+	return r.resolveObjectName(name)
+}
+
+// resolveRef resolves a ref, following symbolic refs such as HEAD.
+func (r *Repository) resolveRef(ref string) (Hash, error) {
+	for range maxSymbolicRefDepth {
+		value, err := r.readRef(ref)
+		if err != nil {
+			return Hash{}, err
+		}
+
+		target, ok := strings.CutPrefix(value, "ref: ")
+		if !ok {
+			return parseHash(value)
+		}
+		ref = target
+	}
+
+	return Hash{}, fmt.Errorf("too many levels of symbolic refs for %s", ref)
+}
+
+// readRef returns the value of a loose ref of the working tree or the repository, or of a packed ref.
+func (r *Repository) readRef(ref string) (string, error) {
+	for _, dir := range []string{r.gitDir, r.commonDir} {
+		contents, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(ref)))
+		if err == nil {
+			return strings.TrimSpace(string(contents)), nil
+		}
+	}
+
+	f, err := os.Open(filepath.Join(r.commonDir, "packed-refs"))
+	if err != nil {
+		return "", fmt.Errorf("ref %s: %w", ref, ErrNotFound)
+	}
+	defer f.Close()
+
+	// packed-refs lines are "<hash> <ref>", followed by "^<hash>" for the peeled annotated tags
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		hash, name, ok := strings.Cut(scanner.Text(), " ")
+		if ok && name == ref {
+			return hash, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("ref %s: %w", ref, ErrNotFound)
+}
+
+// resolveObjectName resolves a full or abbreviated object name.
+func (r *Repository) resolveObjectName(name string) (Hash, error) {
+	name = strings.ToLower(name)
+	if len(name) < minAbbreviatedHash || len(name) > hashSize*2 {
+		return Hash{}, ErrNotFound
+	}
+	if _, err := hex.DecodeString(name[:len(name)/2*2]); err != nil {
+		return Hash{}, ErrNotFound
+	}
+
+	matches := make(map[Hash]bool)
+	entries, _ := os.ReadDir(filepath.Join(r.commonDir, "objects", name[:2]))
+	for _, entry := range entries {
+		if hash, err := parseHash(name[:2] + entry.Name()); err == nil && strings.HasPrefix(hash.String(), name) {
+			matches[hash] = true
+		}
+	}
+
+	for _, p := range r.packs {
+		for _, hash := range p.hashes {
+			if strings.HasPrefix(hash.String(), name) {
+				matches[hash] = true
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Hash{}, ErrNotFound
+	case 1:
+		for hash := range matches {
+			return hash, nil
+		}
+	}
+	return Hash{}, fmt.Errorf("ambiguous object name %s", name)
+}
+
+// peelToCommit resolves the annotated tags to the commit they point to.
+func (r *Repository) peelToCommit(hash Hash) (Hash, error) {
+	for {
+		typ, contents, err := r.readObject(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+
+		switch typ {
+		case commitObject:
+			return hash, nil
+		case tagObject:
+			// the tag starts with "object <hash>"
+			line, _, _ := bytes.Cut(contents, []byte("\n"))
+			target, ok := strings.CutPrefix(string(line), "object ")
+			if !ok {
+				return Hash{}, fmt.Errorf("invalid tag %s", hash)
+			}
+			if hash, err = parseHash(target); err != nil {
+				return Hash{}, err
+			}
+		default:
+			return Hash{}, fmt.Errorf("object %s is not a commit", hash)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"log"
+	"maps"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const componentsRefPrefix = "#/components/"
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// componentRef returns the local reference to a component, e.g. "#/components/headers/RateLimit-Limit".
+func componentRef(componentType, name string) string {
+	return componentsRefPrefix + componentType + "/" + jsonPointerEscaper.Replace(name)
+}
+
+// deleteUnreferencedComponents deletes the components of the given type that are not referenced in the spec.
+// A component is considered used if its reference, or a reference pointing inside it such as
+// "#/components/responses/Error/content", appears anywhere in the JSON serialization of the spec.
+func deleteUnreferencedComponents[V any](oas *openapi3.T, componentType, componentName string, components map[string]V) error {
+	if len(components) == 0 {
+		return nil
+	}
+
+	oasSpecAsBytes, err := oas.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	spec := string(oasSpecAsBytes)
+	maps.DeleteFunc(components, func(k string, _ V) bool {
+		if isComponentReferenced(spec, componentRef(componentType, k)) {
+			return false
+		}
+		log.Printf("Deleting unused %s: %q", componentName, k)
+		return true
+	})
+
+	return nil
+}
+
+// isComponentReferenced reports whether the JSON serialization of the spec has a reference equal to base or pointing inside it.
+func isComponentReferenced(spec, base string) bool {
+	return strings.Contains(spec, `"`+base+`"`) || strings.Contains(spec, `"`+base+`/`)
+}
+
+// RequestBodiesFilter removes unused #/components/requestBodies.
+type RequestBodiesFilter struct {
+	oas *openapi3.T
+}
+
+func (*RequestBodiesFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *RequestBodiesFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil {
+		return nil
+	}
+
+	return deleteUnreferencedComponents(f.oas, "requestBodies", "request body", f.oas.Components.RequestBodies)
+}
+
+// HeadersFilter removes unused #/components/headers.
+type HeadersFilter struct {
+	oas *openapi3.T
+}
+
+func (*HeadersFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *HeadersFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil {
+		return nil
+	}
+
+	return deleteUnreferencedComponents(f.oas, "headers", "header", f.oas.Components.Headers)
+}
+
+// ExamplesFilter removes unused #/components/examples.
+type ExamplesFilter struct {
+	oas *openapi3.T
+}
+
+func (*ExamplesFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *ExamplesFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil {
+		return nil
+	}
+
+	return deleteUnreferencedComponents(f.oas, "examples", "example", f.oas.Components.Examples)
+}
+
+// LinksFilter removes unused #/components/links.
+type LinksFilter struct {
+	oas *openapi3.T
+}
+
+func (*LinksFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *LinksFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil {
+		return nil
+	}
+
+	return deleteUnreferencedComponents(f.oas, "links", "link", f.oas.Components.Links)
+}
+
+// CallbacksFilter removes unused #/components/callbacks.
+type CallbacksFilter struct {
+	oas *openapi3.T
+}
+
+func (*CallbacksFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *CallbacksFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil {
+		return nil
+	}
+
+	return deleteUnreferencedComponents(f.oas, "callbacks", "callback", f.oas.Components.Callbacks)
+}
+
+// SecuritySchemesFilter removes #/components/securitySchemes not used by any security requirement.
+// Security schemes are referenced by name in the root and operation security requirements instead of by $ref.
+type SecuritySchemesFilter struct {
+	oas *openapi3.T
+}
+
+func (*SecuritySchemesFilter) ValidateMetadata() error {
+	return nil
+}
+
+func (f *SecuritySchemesFilter) Apply() error {
+	if f.oas.Paths == nil || f.oas.Components == nil || len(f.oas.Components.SecuritySchemes) == 0 {
+		return nil
+	}
+
+	usedSchemes := usedSecuritySchemes(f.oas)
+	maps.DeleteFunc(f.oas.Components.SecuritySchemes, func(k string, _ *openapi3.SecuritySchemeRef) bool {
+		if usedSchemes[k] {
+			return false
+		}
+		log.Printf("Deleting unused security scheme: %q", k)
+		return true
+	})
+
+	return nil
+}
+
+// usedSecuritySchemes returns the names of the security schemes used in the root and operation security requirements.
+func usedSecuritySchemes(oas *openapi3.T) map[string]bool {
+	used := make(map[string]bool)
+	markUsed := func(requirements openapi3.SecurityRequirements) {
+		for _, requirement := range requirements {
+			for name := range requirement {
+				used[name] = true
+			}
+		}
+	}
+
+	markUsed(oas.Security)
+	for _, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for _, operation := range pathItem.Operations() {
+			if operation == nil || operation.Security == nil {
+				continue
+			}
+			markUsed(*operation.Security)
+		}
+	}
+
+	return used
+}
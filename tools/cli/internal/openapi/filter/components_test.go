@@ -0,0 +1,215 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newComponentsSpec returns a spec where every component type has one used and one unused component.
+// The used components are referenced from the /test operation, directly or through other used components.
+func newComponentsSpec() *openapi3.T {
+	security := openapi3.NewSecurityRequirements().With(openapi3.NewSecurityRequirement().Authenticate("UsedAuth"))
+
+	responses := openapi3.NewResponses()
+	responses.Set("200", &openapi3.ResponseRef{Ref: "#/components/responses/UsedResponse"})
+
+	return &openapi3.T{
+		OpenAPI: "3.0.0",
+		Info:    &openapi3.Info{Version: "1.0"},
+		Paths: openapi3.NewPaths(openapi3.WithPath("/test", &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "testOperation",
+				Security:    security,
+				RequestBody: &openapi3.RequestBodyRef{Ref: "#/components/requestBodies/UsedRequestBody"},
+				Responses:   responses,
+				Callbacks: openapi3.Callbacks{
+					"onEvent": {Ref: "#/components/callbacks/UsedCallback"},
+				},
+			},
+		})),
+		Components: &openapi3.Components{
+			RequestBodies: openapi3.RequestBodies{
+				"UsedRequestBody": {Value: &openapi3.RequestBody{
+					Content: openapi3.Content{
+						"application/json": {
+							Examples: openapi3.Examples{"used": {Ref: "#/components/examples/UsedExample"}},
+						},
+					},
+				}},
+				"UnusedRequestBody": {Value: &openapi3.RequestBody{
+					Content: openapi3.Content{
+						"application/json": {
+							Examples: openapi3.Examples{"unused": {Ref: "#/components/examples/UnusedExample"}},
+						},
+					},
+				}},
+			},
+			Responses: openapi3.ResponseBodies{
+				"UsedResponse": {Value: &openapi3.Response{
+					Description: pointer.Get("OK"),
+					Headers:     openapi3.Headers{"X-Used": {Ref: "#/components/headers/UsedHeader"}},
+					Links:       openapi3.Links{"used": {Ref: "#/components/links/UsedLink"}},
+				}},
+				"UnusedResponse": {Value: &openapi3.Response{
+					Description: pointer.Get("Unused"),
+					Headers:     openapi3.Headers{"X-Unused": {Ref: "#/components/headers/UnusedHeader"}},
+					Links:       openapi3.Links{"unused": {Ref: "#/components/links/UnusedLink"}},
+				}},
+			},
+			Headers: openapi3.Headers{
+				"UsedHeader":   {Value: &openapi3.Header{Parameter: openapi3.Parameter{Description: "used"}}},
+				"UnusedHeader": {Value: &openapi3.Header{Parameter: openapi3.Parameter{Description: "unused"}}},
+			},
+			Examples: openapi3.Examples{
+				"UsedExample":   {Value: &openapi3.Example{Summary: "used"}},
+				"UnusedExample": {Value: &openapi3.Example{Summary: "unused"}},
+			},
+			Links: openapi3.Links{
+				"UsedLink":   {Value: &openapi3.Link{OperationID: "testOperation"}},
+				"UnusedLink": {Value: &openapi3.Link{OperationID: "testOperation"}},
+			},
+			Callbacks: openapi3.Callbacks{
+				"UsedCallback":   {Value: openapi3.NewCallback()},
+				"UnusedCallback": {Value: openapi3.NewCallback()},
+			},
+			SecuritySchemes: openapi3.SecuritySchemes{
+				"UsedAuth":   {Value: openapi3.NewSecurityScheme().WithType("http").WithScheme("digest")},
+				"UnusedAuth": {Value: openapi3.NewSecurityScheme().WithType("http").WithScheme("basic")},
+			},
+		},
+	}
+}
+
+func TestComponentFilters_Apply(t *testing.T) {
+	testCases := []struct {
+		name       string
+		filter     func(oas *openapi3.T) Filter
+		components func(oas *openapi3.T) []string
+	}{
+		{
+			name:       "request bodies",
+			filter:     func(oas *openapi3.T) Filter { return &RequestBodiesFilter{oas: oas} },
+			components: func(oas *openapi3.T) []string { return keys(oas.Components.RequestBodies) },
+		},
+		{
+			name:       "callbacks",
+			filter:     func(oas *openapi3.T) Filter { return &CallbacksFilter{oas: oas} },
+			components: func(oas *openapi3.T) []string { return keys(oas.Components.Callbacks) },
+		},
+		{
+			name:       "security schemes",
+			filter:     func(oas *openapi3.T) Filter { return &SecuritySchemesFilter{oas: oas} },
+			components: func(oas *openapi3.T) []string { return keys(oas.Components.SecuritySchemes) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oas := newComponentsSpec()
+			f := tc.filter(oas)
+
+			require.NoError(t, f.ValidateMetadata())
+			require.NoError(t, f.Apply())
+
+			components := tc.components(oas)
+			require.Len(t, components, 1)
+			assert.Contains(t, components[0], "Used")
+			assert.NotContains(t, components[0], "Unused")
+		})
+	}
+}
+
+func TestExamplesFilter_Apply(t *testing.T) {
+	oas := newComponentsSpec()
+
+	// UnusedExample is still referenced by UnusedRequestBody
+	require.NoError(t, (&ExamplesFilter{oas: oas}).Apply())
+	assert.ElementsMatch(t, []string{"UsedExample", "UnusedExample"}, keys(oas.Components.Examples))
+
+	require.NoError(t, (&RequestBodiesFilter{oas: oas}).Apply())
+	require.NoError(t, (&ExamplesFilter{oas: oas}).Apply())
+	assert.Equal(t, []string{"UsedExample"}, keys(oas.Components.Examples))
+}
+
+func TestHeadersAndLinksFilter_Apply(t *testing.T) {
+	oas := newComponentsSpec()
+
+	// UnusedHeader and UnusedLink are still referenced by UnusedResponse
+	require.NoError(t, (&HeadersFilter{oas: oas}).Apply())
+	require.NoError(t, (&LinksFilter{oas: oas}).Apply())
+	assert.Equal(t, []string{"UnusedHeader", "UsedHeader"}, keys(oas.Components.Headers))
+	assert.Equal(t, []string{"UnusedLink", "UsedLink"}, keys(oas.Components.Links))
+
+	require.NoError(t, (&ResponseFilter{oas: oas}).Apply())
+	require.NoError(t, (&HeadersFilter{oas: oas}).Apply())
+	require.NoError(t, (&LinksFilter{oas: oas}).Apply())
+	assert.Equal(t, []string{"UsedHeader"}, keys(oas.Components.Headers))
+	assert.Equal(t, []string{"UsedLink"}, keys(oas.Components.Links))
+}
+
+func TestResponseFilter_ApplyWithPointerRef(t *testing.T) {
+	oas := newComponentsSpec()
+	oas.Components.Responses["UsedResponse"].Value.Content = openapi3.Content{
+		"application/json": {
+			Schema: &openapi3.SchemaRef{Ref: "#/components/responses/PointedResponse/content/application~1json/schema"},
+		},
+	}
+	oas.Components.Responses["PointedResponse"] = &openapi3.ResponseRef{Value: &openapi3.Response{
+		Description: pointer.Get("Pointed"),
+		Content:     openapi3.NewContentWithJSONSchema(openapi3.NewStringSchema()),
+	}}
+	// Shares the prefix of PointedResponse without being referenced
+	oas.Components.Responses["PointedResponseV2"] = &openapi3.ResponseRef{Value: &openapi3.Response{Description: pointer.Get("Unused")}}
+
+	require.NoError(t, (&ResponseFilter{oas: oas}).Apply())
+	assert.Equal(t, []string{"PointedResponse", "UsedResponse"}, keys(oas.Components.Responses))
+	require.NoError(t, ValidateRefs(oas))
+}
+
+func TestCleanupRefs(t *testing.T) {
+	oas := newComponentsSpec()
+
+	require.NoError(t, CleanupRefs(oas))
+	assert.Equal(t, []string{"UsedRequestBody"}, keys(oas.Components.RequestBodies))
+	assert.Equal(t, []string{"UsedResponse"}, keys(oas.Components.Responses))
+	assert.Equal(t, []string{"UsedHeader"}, keys(oas.Components.Headers))
+	assert.Equal(t, []string{"UsedExample"}, keys(oas.Components.Examples))
+	assert.Equal(t, []string{"UsedLink"}, keys(oas.Components.Links))
+	assert.Equal(t, []string{"UsedCallback"}, keys(oas.Components.Callbacks))
+	assert.Equal(t, []string{"UsedAuth"}, keys(oas.Components.SecuritySchemes))
+}
+
+func TestComponentFilters_NilComponents(t *testing.T) {
+	oas := &openapi3.T{Paths: openapi3.NewPaths()}
+	for _, f := range FiltersToCleanupRefs(oas) {
+		require.NoError(t, f.Apply())
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	slices.Sort(out)
+	return out
+}
@@ -112,15 +112,36 @@ func FiltersToGetVersions(oas *openapi3.T, metadata *Metadata) []Filter {
 	}
 }
 
+// FiltersToCleanupRefs returns a list of filters that remove the tags and components no longer used by the operations.
+// The order matters: components are removed before the components they can reference, so that components only
+// used by other unused components are removed as well.
 func FiltersToCleanupRefs(oas *openapi3.T) []Filter {
 	return []Filter{
 		&TagsFilter{oas: oas},
+		&CallbacksFilter{oas: oas},
+		&RequestBodiesFilter{oas: oas},
 		&ResponseFilter{oas: oas},
+		&LinksFilter{oas: oas},
+		&HeadersFilter{oas: oas},
 		&ParametersFilter{oas: oas},
+		&ExamplesFilter{oas: oas},
+		&SecuritySchemesFilter{oas: oas},
 		&SchemasFilter{oas: oas},
 	}
 }
 
+// CleanupRefs removes the tags and components no longer used by the operations and
+// validates that the resulting specification has no dangling references.
+func CleanupRefs(oas *openapi3.T) error {
+	for _, f := range FiltersToCleanupRefs(oas) {
+		if err := f.Apply(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateRefs(oas)
+}
+
 func ApplyFilters(doc *openapi3.T, metadata *Metadata, filters func(oas *openapi3.T, metadata *Metadata) []Filter) (*openapi3.T, error) {
 	if doc == nil {
 		return nil, errors.New("openapi document is nil")
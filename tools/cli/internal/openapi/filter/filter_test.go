@@ -130,3 +130,10 @@ func TestFiltersToGetVersions(t *testing.T) {
 
 	assert.Len(t, filters, 1)
 }
+
+func TestFiltersToCleanupRefs(t *testing.T) {
+	doc := &openapi3.T{}
+	filters := FiltersToCleanupRefs(doc)
+
+	assert.Len(t, filters, 10)
+}
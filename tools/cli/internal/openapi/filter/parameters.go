@@ -15,13 +15,10 @@
 package filter
 
 import (
-	"log"
-	"maps"
-	"strings"
-
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
+// ParametersFilter removes unused #/components/parameters.
 type ParametersFilter struct {
 	oas *openapi3.T
 }
@@ -39,26 +36,5 @@ func (f *ParametersFilter) Apply() error {
 		return nil
 	}
 
-	oasSpecAsBytes, err := f.oas.MarshalJSON()
-	if err != nil {
-		return err
-	}
-
-	spec := string(oasSpecAsBytes)
-	parametersToDelete := make([]string, 0)
-	for k := range f.oas.Components.Parameters {
-		ref := "#/components/parameters/" + k
-		if !strings.Contains(spec, ref) {
-			parametersToDelete = append(parametersToDelete, k)
-		}
-	}
-
-	for _, parameterToDelete := range parametersToDelete {
-		log.Printf("Deleting unused parameter: %q", parameterToDelete)
-		maps.DeleteFunc(f.oas.Components.Parameters, func(k string, _ *openapi3.ParameterRef) bool {
-			return k == parameterToDelete
-		})
-	}
-
-	return nil
+	return deleteUnreferencedComponents(f.oas, "parameters", "parameter", f.oas.Components.Parameters)
 }
@@ -0,0 +1,135 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	componentRefRegex  = regexp.MustCompile(`"#/components/([^/"]+)/([^"]+)"`)
+	jsonPointerDecoder = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// ValidateRefs checks that every local #/components reference in the specification resolves to an existing component.
+// It returns an error listing all the dangling references found.
+// Security requirements without a matching security scheme are only logged, as they are also used as
+// markers of operations without authentication, e.g. "Unauthenticated": [].
+func ValidateRefs(oas *openapi3.T) error {
+	if oas == nil {
+		return errors.New("OpenAPI spec is nil")
+	}
+
+	dangling, err := findDanglingRefs(oas)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range missingSecuritySchemes(oas) {
+		log.Printf("[WARN] Security requirement %q has no matching security scheme", name)
+	}
+
+	if len(dangling) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("found %d dangling references: %s", len(dangling), strings.Join(dangling, ", "))
+}
+
+// findDanglingRefs returns the sorted list of references that do not resolve to an existing component.
+func findDanglingRefs(oas *openapi3.T) ([]string, error) {
+	oasSpecAsBytes, err := oas.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	danglingSet := make(map[string]bool)
+	for _, match := range componentRefRegex.FindAllStringSubmatch(string(oasSpecAsBytes), -1) {
+		componentType := match[1]
+		// The reference can point inside the component, e.g. #/components/schemas/User/properties/name
+		name, _, _ := strings.Cut(match[2], "/")
+		name = jsonPointerDecoder.Replace(name)
+
+		exists, known := componentExists(oas.Components, componentType, name)
+		if known && !exists {
+			danglingSet[componentRef(componentType, name)] = true
+		}
+	}
+
+	dangling := make([]string, 0, len(danglingSet))
+	for ref := range danglingSet {
+		dangling = append(dangling, ref)
+	}
+	slices.Sort(dangling)
+
+	return dangling, nil
+}
+
+// missingSecuritySchemes returns the sorted names used in security requirements that have no security scheme.
+func missingSecuritySchemes(oas *openapi3.T) []string {
+	if oas.Paths == nil {
+		return nil
+	}
+
+	var missing []string
+	for name := range usedSecuritySchemes(oas) {
+		if exists, _ := componentExists(oas.Components, "securitySchemes", name); !exists {
+			missing = append(missing, name)
+		}
+	}
+	slices.Sort(missing)
+
+	return missing
+}
+
+// componentExists checks if the component with the given type and name exists.
+// known is false when the component type is not supported.
+func componentExists(components *openapi3.Components, componentType, name string) (exists, known bool) {
+	if components == nil {
+		components = &openapi3.Components{}
+	}
+
+	switch componentType {
+	case "schemas":
+		_, exists = components.Schemas[name]
+	case "parameters":
+		_, exists = components.Parameters[name]
+	case "headers":
+		_, exists = components.Headers[name]
+	case "requestBodies":
+		_, exists = components.RequestBodies[name]
+	case "responses":
+		_, exists = components.Responses[name]
+	case "securitySchemes":
+		_, exists = components.SecuritySchemes[name]
+	case "examples":
+		_, exists = components.Examples[name]
+	case "links":
+		_, exists = components.Links[name]
+	case "callbacks":
+		_, exists = components.Callbacks[name]
+	default:
+		return false, false
+	}
+
+	return exists, true
+}
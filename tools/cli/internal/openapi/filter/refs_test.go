@@ -0,0 +1,96 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRefs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		oas      func() *openapi3.T
+		errorMsg string
+	}{
+		{
+			name: "no dangling references",
+			oas:  newComponentsSpec,
+		},
+		{
+			name: "missing components",
+			oas: func() *openapi3.T {
+				oas := newComponentsSpec()
+				delete(oas.Components.Headers, "UsedHeader")
+				delete(oas.Components.RequestBodies, "UsedRequestBody")
+				return oas
+			},
+			errorMsg: "found 2 dangling references: #/components/headers/UsedHeader, #/components/requestBodies/UsedRequestBody",
+		},
+		{
+			name: "missing schema referenced by property",
+			oas: func() *openapi3.T {
+				oas := newComponentsSpec()
+				oas.Components.Schemas = openapi3.Schemas{
+					"User": {Value: &openapi3.Schema{
+						Properties: openapi3.Schemas{
+							"group": {Ref: "#/components/schemas/Group"},
+						},
+					}},
+				}
+				return oas
+			},
+			errorMsg: "found 1 dangling references: #/components/schemas/Group",
+		},
+		{
+			name: "missing security scheme is not a dangling reference",
+			oas: func() *openapi3.T {
+				oas := newComponentsSpec()
+				delete(oas.Components.SecuritySchemes, "UsedAuth")
+				return oas
+			},
+		},
+		{
+			name: "nil components",
+			oas: func() *openapi3.T {
+				oas := newComponentsSpec()
+				oas.Components = nil
+				return oas
+			},
+			errorMsg: "found 3 dangling references",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRefs(tc.oas())
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestMissingSecuritySchemes(t *testing.T) {
+	oas := newComponentsSpec()
+	require.Empty(t, missingSecuritySchemes(oas))
+
+	delete(oas.Components.SecuritySchemes, "UsedAuth")
+	require.Equal(t, []string{"UsedAuth"}, missingSecuritySchemes(oas))
+}
@@ -15,13 +15,10 @@
 package filter
 
 import (
-	"log"
-	"maps"
-	"strings"
-
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
+// ResponseFilter removes unused #/components/responses.
 type ResponseFilter struct {
 	oas *openapi3.T
 }
@@ -39,26 +36,5 @@ func (f *ResponseFilter) Apply() error {
 		return nil
 	}
 
-	oasSpecAsBytes, err := f.oas.MarshalJSON()
-	if err != nil {
-		return err
-	}
-
-	spec := string(oasSpecAsBytes)
-	responsesToDelete := make([]string, 0)
-	for k := range f.oas.Components.Responses {
-		ref := "#/components/responses/" + k
-		if !strings.Contains(spec, ref) {
-			responsesToDelete = append(responsesToDelete, k)
-		}
-	}
-
-	for _, responseToDelete := range responsesToDelete {
-		log.Printf("Deleting unused response: %q", responseToDelete)
-		maps.DeleteFunc(f.oas.Components.Responses, func(k string, _ *openapi3.ResponseRef) bool {
-			return k == responseToDelete
-		})
-	}
-
-	return nil
+	return deleteUnreferencedComponents(f.oas, "responses", "response", f.oas.Components.Responses)
 }
@@ -96,7 +96,7 @@ func (f *SchemasFilter) discoverUsedSchemas() (map[string]bool, error) {
 		queue = queue[1:]
 
 		if schemaRef, exists := f.oas.Components.Schemas[schemaName]; exists {
-			f.discoverSchemaRefsInSchema(schemaRef, markToDiscover)
+			discoverSchemaRefsInSchema(schemaRef, markToDiscover)
 		}
 	}
 
@@ -133,7 +133,7 @@ func (f *SchemasFilter) discoverUsedRootSchemas(onDiscovered func(schemaName str
 
 // discoverSchemaRefsInSchema recursively finds all schema references within a given schema.
 // It checks properties, polymorphism constructs (allOf, anyOf, oneOf), items, not, and additionalProperties.
-func (f *SchemasFilter) discoverSchemaRefsInSchema(schema *openapi3.SchemaRef, onDiscovered func(string)) {
+func discoverSchemaRefsInSchema(schema *openapi3.SchemaRef, onDiscovered func(string)) {
 	if schema == nil {
 		return
 	}
@@ -150,18 +150,18 @@ func (f *SchemasFilter) discoverSchemaRefsInSchema(schema *openapi3.SchemaRef, o
 
 	// Check properties for refs
 	for _, ref := range schema.Value.Properties {
-		f.discoverSchemaRefsInSchema(ref, onDiscovered)
+		discoverSchemaRefsInSchema(ref, onDiscovered)
 	}
 
 	// Check polymorphism for refs
 	for _, ref := range schema.Value.AllOf {
-		f.discoverSchemaRefsInSchema(ref, onDiscovered)
+		discoverSchemaRefsInSchema(ref, onDiscovered)
 	}
 	for _, ref := range schema.Value.AnyOf {
-		f.discoverSchemaRefsInSchema(ref, onDiscovered)
+		discoverSchemaRefsInSchema(ref, onDiscovered)
 	}
 	for _, ref := range schema.Value.OneOf {
-		f.discoverSchemaRefsInSchema(ref, onDiscovered)
+		discoverSchemaRefsInSchema(ref, onDiscovered)
 	}
 
 	// Check discriminator mappings for refs
@@ -174,9 +174,20 @@ func (f *SchemasFilter) discoverSchemaRefsInSchema(schema *openapi3.SchemaRef, o
 	}
 
 	// Check array items, negation, and additional properties
-	f.discoverSchemaRefsInSchema(schema.Value.Items, onDiscovered)
-	f.discoverSchemaRefsInSchema(schema.Value.Not, onDiscovered)
-	f.discoverSchemaRefsInSchema(schema.Value.AdditionalProperties.Schema, onDiscovered)
+	discoverSchemaRefsInSchema(schema.Value.Items, onDiscovered)
+	discoverSchemaRefsInSchema(schema.Value.Not, onDiscovered)
+	discoverSchemaRefsInSchema(schema.Value.AdditionalProperties.Schema, onDiscovered)
+}
+
+// SchemaRefs returns the sorted names of the component schemas directly referenced by the given schema.
+// References are not followed, so schemas referenced transitively through other component schemas are not included.
+func SchemaRefs(schema *openapi3.SchemaRef) []string {
+	refs := make(map[string]bool)
+	discoverSchemaRefsInSchema(schema, func(name string) {
+		refs[name] = true
+	})
+
+	return slices.Sorted(maps.Keys(refs))
 }
 
 func isSchemaRefString(ref string) bool {
@@ -664,3 +664,17 @@ func unusedSchemasWithCircularDependencyScenario() testCase {
 		},
 	}
 }
+
+func TestSchemaRefs(t *testing.T) {
+	schema := &openapi3.SchemaRef{Value: &openapi3.Schema{
+		Properties: openapi3.Schemas{
+			"name":   openapi3.NewSchemaRef("#/components/schemas/Name", nil),
+			"nested": {Value: &openapi3.Schema{Items: openapi3.NewSchemaRef("#/components/schemas/Item", nil)}},
+		},
+		AllOf: openapi3.SchemaRefs{openapi3.NewSchemaRef("#/components/schemas/Base", nil)},
+	}}
+
+	require.Equal(t, []string{"Base", "Item", "Name"}, SchemaRefs(schema))
+	require.Equal(t, []string{"Name"}, SchemaRefs(openapi3.NewSchemaRef("#/components/schemas/Name", nil)))
+	require.Empty(t, SchemaRefs(nil))
+}
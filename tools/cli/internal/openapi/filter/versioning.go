@@ -29,8 +29,6 @@ type VersioningFilter struct {
 	metadata *Metadata
 }
 
-const versionExtension = "x-xgen-version"
-
 // VersionConfig contains the information needed during the versioning filtering of the OAS.
 // It contains the parsed operations, the operations that need to be removed and the version
 // under scrutiny.
@@ -109,11 +107,23 @@ func (f *VersioningFilter) applyInternal(path *openapi3.PathItem) error {
 		config.parsedOperations[op.OperationID] = opConfig
 
 		opConfig.latestMatchedVersion = apiversion.FindLatestContentVersionMatched(op, f.metadata.targetVersion)
+
+		// Operations without versioned content are kept as they are if their x-xgen-version extension matches
+		if operationVersion, ok := unversionedOperationVersion(op); ok {
+			if !operationVersion.Equal(opConfig.latestMatchedVersion) {
+				log.Printf("Removing operation: %s", op.OperationID)
+				path.SetOperation(opKey, nil)
+			}
+			continue
+		}
+
+		// Operations versioned only in their request body are kept when the request body matches the version
+		requestBodyOnlyVersioning := !hasVersionedResponse(op)
 		if err := updateResponses(op, config); err != nil {
 			return err
 		}
 
-		if !opConfig.hasMinValidResponse {
+		if !opConfig.hasMinValidResponse && (!requestBodyOnlyVersioning || !hasVersionedRequestBody(op, opConfig.latestMatchedVersion)) {
 			log.Printf("Removing operation: %s", op.OperationID)
 			path.SetOperation(opKey, nil)
 		}
@@ -149,6 +159,41 @@ func updateResponses(op *openapi3.Operation, config *VersionConfig) error {
 	return nil
 }
 
+// hasVersionedResponse reports whether a response of the operation has a versioned content.
+func hasVersionedResponse(op *openapi3.Operation) bool {
+	if op.Responses == nil {
+		return false
+	}
+
+	for _, response := range op.Responses.Map() {
+		if response != nil && response.Value != nil && isVersionedContent(response.Value.Content) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVersionedRequestBody reports whether the request body has a content with the given version.
+// It keeps the operations versioned only in their request body, e.g. operations returning 204 No Content.
+func hasVersionedRequestBody(op *openapi3.Operation, version *apiversion.APIVersion) bool {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return false
+	}
+
+	content, err := filterContentExactMatch(op.RequestBody.Value.Content, version)
+	return err == nil && len(content) > 0
+}
+
+// unversionedOperationVersion returns the version declared by the x-xgen-version extension of an operation
+// without versioned content.
+func unversionedOperationVersion(op *openapi3.Operation) (*apiversion.APIVersion, bool) {
+	if apiversion.HasVersionedContent(op) {
+		return nil, false
+	}
+
+	return apiversion.OperationVersion(op)
+}
+
 func updateRequestBody(op *openapi3.Operation, opConfig *OperationConfig) error {
 	if op.RequestBody == nil || op.RequestBody.Value == nil {
 		return nil
@@ -272,8 +317,8 @@ func filterContentExactMatch(content map[string]*openapi3.MediaType, version *ap
 
 // updateSingleMediaTypeExtension updates the media type extension with the version in string format.
 func updateSingleMediaTypeExtension(m *openapi3.MediaType, version *apiversion.APIVersion) {
-	if _, ok := m.Extensions[versionExtension]; ok {
-		m.Extensions[versionExtension] = version.String()
+	if _, ok := m.Extensions[apiversion.VersionExtension]; ok {
+		m.Extensions[apiversion.VersionExtension] = version.String()
 	}
 }
 
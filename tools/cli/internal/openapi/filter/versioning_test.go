@@ -97,6 +97,178 @@ func TestPathFilter_filterRequestBody(t *testing.T) {
 	assert.NotNil(t, path.Get.RequestBody.Value.Content.Get("application/vnd.atlas.2023-11-15+json"))
 }
 
+func TestPathFilter_requestBodyOnlyVersioning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		version       string
+		expectedFound bool
+		expectedType  string
+	}{
+		{name: "exact match", version: "2024-05-30", expectedFound: true, expectedType: "application/vnd.atlas.2024-05-30+json"},
+		{name: "approx match", version: "2023-06-01", expectedFound: true, expectedType: "application/vnd.atlas.2023-01-01+json"},
+		{name: "before first version", version: "2022-01-01", expectedFound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := apiversion.New(apiversion.WithVersion(tc.version))
+			require.NoError(t, err)
+
+			filter := &VersioningFilter{
+				metadata: &Metadata{targetVersion: version},
+			}
+
+			responses := &openapi3.Responses{}
+			responses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+			path := &openapi3.PathItem{
+				Patch: &openapi3.Operation{
+					OperationID: "updateResource",
+					Responses:   responses,
+					RequestBody: &openapi3.RequestBodyRef{
+						Value: &openapi3.RequestBody{
+							Content: map[string]*openapi3.MediaType{
+								"application/vnd.atlas.2023-01-01+json": {},
+								"application/vnd.atlas.2024-05-30+json": {},
+							},
+						},
+					},
+				},
+			}
+
+			require.NoError(t, filter.applyInternal(path))
+			if !tc.expectedFound {
+				assert.Nil(t, path.Patch)
+				return
+			}
+
+			require.NotNil(t, path.Patch)
+			assert.Len(t, path.Patch.RequestBody.Value.Content, 1)
+			assert.NotNil(t, path.Patch.RequestBody.Value.Content.Get(tc.expectedType))
+		})
+	}
+}
+
+func TestPathFilter_requestBodyAndResponsesVersions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		version             string
+		expectedFound       bool
+		expectedType        string
+		expectedRequestType string
+	}{
+		{
+			name:          "request body version newer than the responses",
+			version:       "2024-06-01",
+			expectedFound: true,
+			expectedType:  "application/vnd.atlas.2023-01-01+json",
+			// The request body follows the version matched by the responses
+			expectedRequestType: "application/vnd.atlas.2023-01-01+json",
+		},
+		{
+			name:                "exact match",
+			version:             "2023-01-01",
+			expectedFound:       true,
+			expectedType:        "application/vnd.atlas.2023-01-01+json",
+			expectedRequestType: "application/vnd.atlas.2023-01-01+json",
+		},
+		{name: "only the request body matches", version: "2022-06-01", expectedFound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := apiversion.New(apiversion.WithVersion(tc.version))
+			require.NoError(t, err)
+
+			filter := &VersioningFilter{
+				metadata: &Metadata{targetVersion: version},
+			}
+
+			responses := &openapi3.Responses{}
+			responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{
+				Content: map[string]*openapi3.MediaType{
+					"application/vnd.atlas.2023-01-01+json": {},
+				},
+			}})
+			path := &openapi3.PathItem{
+				Patch: &openapi3.Operation{
+					OperationID: "updateResource",
+					Responses:   responses,
+					RequestBody: &openapi3.RequestBodyRef{
+						Value: &openapi3.RequestBody{
+							Content: map[string]*openapi3.MediaType{
+								"application/vnd.atlas.2022-01-01+json": {},
+								"application/vnd.atlas.2023-01-01+json": {},
+								"application/vnd.atlas.2024-05-30+json": {},
+							},
+						},
+					},
+				},
+			}
+
+			require.NoError(t, filter.applyInternal(path))
+			if !tc.expectedFound {
+				assert.Nil(t, path.Patch)
+				return
+			}
+
+			require.NotNil(t, path.Patch)
+			response := path.Patch.Responses.Value("200")
+			require.NotNil(t, response)
+			assert.Len(t, response.Value.Content, 1)
+			assert.NotNil(t, response.Value.Content.Get(tc.expectedType))
+			assert.Len(t, path.Patch.RequestBody.Value.Content, 1)
+			assert.NotNil(t, path.Patch.RequestBody.Value.Content.Get(tc.expectedRequestType))
+		})
+	}
+}
+
+func TestPathFilter_versionExtension(t *testing.T) {
+	testCases := []struct {
+		name          string
+		version       string
+		expectedFound bool
+	}{
+		{name: "exact match", version: "2024-01-01", expectedFound: true},
+		{name: "approx match", version: "2025-01-01", expectedFound: true},
+		{name: "before version", version: "2023-01-01", expectedFound: false},
+		{name: "preview", version: "preview", expectedFound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := apiversion.New(apiversion.WithVersion(tc.version))
+			require.NoError(t, err)
+
+			filter := &VersioningFilter{
+				metadata: &Metadata{targetVersion: version},
+			}
+
+			responses := &openapi3.Responses{}
+			responses.Set("200", &openapi3.ResponseRef{
+				Value: &openapi3.Response{
+					Content: map[string]*openapi3.MediaType{"application/json": {}},
+				},
+			})
+			path := &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					OperationID: "getResource",
+					Responses:   responses,
+					Extensions:  map[string]any{"x-xgen-version": "2024-01-01"},
+				},
+			}
+
+			require.NoError(t, filter.applyInternal(path))
+			if !tc.expectedFound {
+				assert.Nil(t, path.Get)
+				return
+			}
+
+			require.NotNil(t, path.Get)
+			assert.NotNil(t, path.Get.Responses.Value("200").Value.Content.Get("application/json"))
+		})
+	}
+}
+
 func TestPathFilter_keepExtension(t *testing.T) {
 	version, err := apiversion.New(apiversion.WithVersion("2023-11-15"))
 	require.NoError(t, err)
@@ -0,0 +1,342 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/filter"
+)
+
+type NodeKind string
+
+const (
+	OperationNode NodeKind = "operation"
+	SchemaNode    NodeKind = "schema"
+
+	// RequestLocation is the location of schemas referenced by the operation parameters or request body.
+	RequestLocation = "request"
+	// ResponseLocation is the location of schemas referenced by the operation responses.
+	ResponseLocation = "response"
+)
+
+// Node is either an operation or a component schema.
+type Node struct {
+	ID     string   `json:"id"`
+	Kind   NodeKind `json:"kind"`
+	Label  string   `json:"label"`
+	Method string   `json:"method,omitempty"`
+	Path   string   `json:"path,omitempty"`
+}
+
+// Edge is a reference from an operation to a schema or from a schema to another schema.
+// Location is only set for operation edges and is either "request" or "response".
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Location string `json:"location,omitempty"`
+}
+
+// Graph is the reference graph of the operations and component schemas of an OpenAPI spec.
+// It contains only the schemas reachable from the operations.
+type Graph struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+}
+
+// SchemaRef is a component schema directly referenced by an operation.
+type SchemaRef struct {
+	Name     string
+	Location string
+}
+
+// New builds the operation to schema and schema to schema reference graph of the spec.
+func New(spec *openapi3.T) (*Graph, error) {
+	if spec == nil {
+		return nil, errors.New("OpenAPI spec is nil")
+	}
+
+	g := &Graph{
+		Nodes: []*Node{},
+		Edges: []*Edge{},
+	}
+
+	schemas := make(map[string]bool)
+	var queue []string
+	addSchema := func(name string) {
+		if schemas[name] {
+			return
+		}
+		schemas[name] = true
+		queue = append(queue, name)
+		g.Nodes = append(g.Nodes, &Node{ID: SchemaID(name), Kind: SchemaNode, Label: name})
+	}
+
+	if spec.Paths != nil {
+		for path, pathItem := range spec.Paths.Map() {
+			if pathItem == nil {
+				continue
+			}
+			for method, operation := range pathItem.Operations() {
+				if operation == nil {
+					continue
+				}
+				node := newOperationNode(path, method, operation)
+				g.Nodes = append(g.Nodes, node)
+
+				for _, ref := range OperationSchemaRefs(spec, operation) {
+					addSchema(ref.Name)
+					g.Edges = append(g.Edges, &Edge{From: node.ID, To: SchemaID(ref.Name), Location: ref.Location})
+				}
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if spec.Components == nil {
+			continue
+		}
+		for _, ref := range filter.SchemaRefs(spec.Components.Schemas[name]) {
+			addSchema(ref)
+			g.Edges = append(g.Edges, &Edge{From: SchemaID(name), To: SchemaID(ref)})
+		}
+	}
+
+	g.sort()
+	return g, nil
+}
+
+// Node returns the node with the given ID, or nil if the graph does not contain it.
+func (g *Graph) Node(id string) *Node {
+	for _, node := range g.Nodes {
+		if node.ID == id {
+			return node
+		}
+	}
+	return nil
+}
+
+// OperationEdgesTo returns the operation edges that reference the schema, either directly or
+// transitively through other component schemas. The returned edges point to the given schema
+// and are unique per operation and location.
+func (g *Graph) OperationEdgesTo(schemaName string) []*Edge {
+	target := SchemaID(schemaName)
+
+	referencedBy := make(map[string][]string)
+	for _, edge := range g.Edges {
+		if edge.Location == "" {
+			referencedBy[edge.To] = append(referencedBy[edge.To], edge.From)
+		}
+	}
+
+	// Walk the schema edges backwards to find all the schemas that reach the target schema
+	reaching := map[string]bool{target: true}
+	queue := []string{target}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, from := range referencedBy[id] {
+			if !reaching[from] {
+				reaching[from] = true
+				queue = append(queue, from)
+			}
+		}
+	}
+
+	seen := make(map[Edge]bool)
+	edges := []*Edge{}
+	for _, edge := range g.Edges {
+		if edge.Location == "" || !reaching[edge.To] {
+			continue
+		}
+		out := Edge{From: edge.From, To: target, Location: edge.Location}
+		if seen[out] {
+			continue
+		}
+		seen[out] = true
+		edges = append(edges, &out)
+	}
+
+	return edges
+}
+
+// OperationID returns the graph node ID of an operation.
+// Operations without an operationId are identified by their method and path.
+func OperationID(path, method string, operation *openapi3.Operation) string {
+	return string(OperationNode) + ":" + operationLabel(path, method, operation)
+}
+
+// SchemaID returns the graph node ID of a component schema.
+func SchemaID(name string) string {
+	return string(SchemaNode) + ":" + name
+}
+
+func newOperationNode(path, method string, operation *openapi3.Operation) *Node {
+	return &Node{
+		ID:     OperationID(path, method, operation),
+		Kind:   OperationNode,
+		Label:  operationLabel(path, method, operation),
+		Method: method,
+		Path:   path,
+	}
+}
+
+func operationLabel(path, method string, operation *openapi3.Operation) string {
+	if operation.OperationID != "" {
+		return operation.OperationID
+	}
+	return method + " " + path
+}
+
+// OperationSchemaRefs returns the component schemas directly referenced by the operation parameters,
+// request body and responses, including the ones referenced through other components such as
+// #/components/parameters or #/components/responses. The referenced components are looked up in
+// the spec, so the refs of the operation don't need to be resolved.
+func OperationSchemaRefs(spec *openapi3.T, operation *openapi3.Operation) []SchemaRef {
+	components := &openapi3.Components{}
+	if spec != nil && spec.Components != nil {
+		components = spec.Components
+	}
+
+	refs := make(map[SchemaRef]bool)
+	collect := func(location string, schema *openapi3.SchemaRef) {
+		for _, name := range filter.SchemaRefs(schema) {
+			refs[SchemaRef{Name: name, Location: location}] = true
+		}
+	}
+	collectContent := func(location string, content openapi3.Content) {
+		for _, mediaType := range content {
+			if mediaType != nil {
+				collect(location, mediaType.Schema)
+			}
+		}
+	}
+
+	for _, parameterRef := range operation.Parameters {
+		if parameter := parameterValue(components, parameterRef); parameter != nil {
+			collect(RequestLocation, parameter.Schema)
+			collectContent(RequestLocation, parameter.Content)
+		}
+	}
+
+	if requestBody := requestBodyValue(components, operation.RequestBody); requestBody != nil {
+		collectContent(RequestLocation, requestBody.Content)
+	}
+
+	if operation.Responses != nil {
+		for _, responseRef := range operation.Responses.Map() {
+			response := responseValue(components, responseRef)
+			if response == nil {
+				continue
+			}
+			collectContent(ResponseLocation, response.Content)
+			for _, headerRef := range response.Headers {
+				if header := headerValue(components, headerRef); header != nil {
+					collect(ResponseLocation, header.Schema)
+				}
+			}
+		}
+	}
+
+	out := make([]SchemaRef, 0, len(refs))
+	for ref := range refs {
+		out = append(out, ref)
+	}
+	slices.SortFunc(out, func(a, b SchemaRef) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Location, b.Location))
+	})
+
+	return out
+}
+
+func parameterValue(components *openapi3.Components, ref *openapi3.ParameterRef) *openapi3.Parameter {
+	if ref == nil {
+		return nil
+	}
+	return componentValue(ref.Value, ref.Ref, "#/components/parameters/", func(name string) (*openapi3.Parameter, string) {
+		if component := components.Parameters[name]; component != nil {
+			return component.Value, component.Ref
+		}
+		return nil, ""
+	})
+}
+
+func requestBodyValue(components *openapi3.Components, ref *openapi3.RequestBodyRef) *openapi3.RequestBody {
+	if ref == nil {
+		return nil
+	}
+	return componentValue(ref.Value, ref.Ref, "#/components/requestBodies/", func(name string) (*openapi3.RequestBody, string) {
+		if component := components.RequestBodies[name]; component != nil {
+			return component.Value, component.Ref
+		}
+		return nil, ""
+	})
+}
+
+func responseValue(components *openapi3.Components, ref *openapi3.ResponseRef) *openapi3.Response {
+	if ref == nil {
+		return nil
+	}
+	return componentValue(ref.Value, ref.Ref, "#/components/responses/", func(name string) (*openapi3.Response, string) {
+		if component := components.Responses[name]; component != nil {
+			return component.Value, component.Ref
+		}
+		return nil, ""
+	})
+}
+
+func headerValue(components *openapi3.Components, ref *openapi3.HeaderRef) *openapi3.Header {
+	if ref == nil {
+		return nil
+	}
+	return componentValue(ref.Value, ref.Ref, "#/components/headers/", func(name string) (*openapi3.Header, string) {
+		if component := components.Headers[name]; component != nil {
+			return component.Value, component.Ref
+		}
+		return nil, ""
+	})
+}
+
+// componentValue returns the value of a ref, looking up the referenced component by name when the ref is not resolved,
+// as in the specs copied by the filters. Chained refs are followed, and nil is returned for missing components or cycles.
+func componentValue[T any](value *T, ref, prefix string, lookup func(name string) (*T, string)) *T {
+	seen := make(map[string]bool)
+	for value == nil && ref != "" && !seen[ref] {
+		seen[ref] = true
+		name, ok := strings.CutPrefix(ref, prefix)
+		if !ok {
+			return nil
+		}
+		value, ref = lookup(name)
+	}
+	return value
+}
+
+// sort orders nodes by kind and ID, and edges by source, target and location, so that the output is stable.
+func (g *Graph) sort() {
+	slices.SortFunc(g.Nodes, func(a, b *Node) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.ID, b.ID))
+	})
+	slices.SortFunc(g.Edges, func(a, b *Edge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To), cmp.Compare(a.Location, b.Location))
+	})
+}
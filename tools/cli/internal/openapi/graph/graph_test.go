@@ -0,0 +1,229 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package graph
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphTestSpec() *openapi3.T {
+	schemaRef := func(name string) *openapi3.SchemaRef {
+		return openapi3.NewSchemaRef("#/components/schemas/"+name, nil)
+	}
+	jsonContent := func(name string) openapi3.Content {
+		return openapi3.Content{"application/json": &openapi3.MediaType{Schema: schemaRef(name)}}
+	}
+
+	responses := openapi3.NewResponses()
+	responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{Content: jsonContent("Cluster")}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/clusters/{clusterName}", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "getCluster",
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "clusterName", In: "path", Schema: schemaRef("ClusterName")}},
+			},
+			Responses: responses,
+		},
+		Patch: &openapi3.Operation{
+			RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{Content: jsonContent("Cluster")}},
+			Responses:   responses,
+		},
+	})
+
+	return &openapi3.T{
+		Paths: paths,
+		Components: &openapi3.Components{
+			Schemas: openapi3.Schemas{
+				"Cluster": &openapi3.SchemaRef{Value: &openapi3.Schema{
+					Properties: openapi3.Schemas{
+						"name":     schemaRef("ClusterName"),
+						"provider": schemaRef("Provider"),
+					},
+				}},
+				"ClusterName": &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+				"Provider":    &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+				"Unused":      &openapi3.SchemaRef{Value: &openapi3.Schema{Properties: openapi3.Schemas{"provider": schemaRef("Provider")}}},
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	g, err := New(newGraphTestSpec())
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(g.Nodes))
+	for _, node := range g.Nodes {
+		ids = append(ids, node.ID)
+	}
+	assert.Equal(t, []string{
+		"operation:PATCH /clusters/{clusterName}",
+		"operation:getCluster",
+		"schema:Cluster",
+		"schema:ClusterName",
+		"schema:Provider",
+	}, ids)
+
+	assert.Equal(t, []*Edge{
+		{From: "operation:PATCH /clusters/{clusterName}", To: "schema:Cluster", Location: RequestLocation},
+		{From: "operation:PATCH /clusters/{clusterName}", To: "schema:Cluster", Location: ResponseLocation},
+		{From: "operation:getCluster", To: "schema:Cluster", Location: ResponseLocation},
+		{From: "operation:getCluster", To: "schema:ClusterName", Location: RequestLocation},
+		{From: "schema:Cluster", To: "schema:ClusterName"},
+		{From: "schema:Cluster", To: "schema:Provider"},
+	}, g.Edges)
+}
+
+func TestNew_NilSpec(t *testing.T) {
+	_, err := New(nil)
+	require.Error(t, err)
+}
+
+func TestOperationSchemaRefs(t *testing.T) {
+	spec := newGraphTestSpec()
+	operation := spec.Paths.Find("/clusters/{clusterName}").Get
+
+	assert.Equal(t, []SchemaRef{
+		{Name: "Cluster", Location: ResponseLocation},
+		{Name: "ClusterName", Location: RequestLocation},
+	}, OperationSchemaRefs(spec, operation))
+}
+
+const componentRefsSpec = `{
+  "openapi": "3.0.1",
+  "info": {"title": "Test", "version": "2.0"},
+  "paths": {
+    "/clusters/{clusterName}": {
+      "patch": {
+        "operationId": "updateCluster",
+        "parameters": [{"$ref": "#/components/parameters/clusterName"}],
+        "requestBody": {"$ref": "#/components/requestBodies/Cluster"},
+        "responses": {
+          "200": {"$ref": "#/components/responses/Cluster"},
+          "400": {"$ref": "#/components/responses/badRequest"}
+        }
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "clusterName": {"name": "clusterName", "in": "path", "required": true, "schema": {"$ref": "#/components/schemas/ClusterName"}}
+    },
+    "requestBodies": {
+      "Cluster": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Cluster"}}}}
+    },
+    "responses": {
+      "Cluster": {
+        "description": "OK",
+        "headers": {"RateLimit": {"$ref": "#/components/headers/RateLimit"}},
+        "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Cluster"}}}
+      },
+      "badRequest": {"$ref": "#/components/responses/error"},
+      "error": {"description": "Error", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ApiError"}}}}
+    },
+    "headers": {
+      "RateLimit": {"schema": {"$ref": "#/components/schemas/RateLimit"}}
+    },
+    "schemas": {
+      "ApiError": {"type": "object"},
+      "Cluster": {"type": "object", "properties": {"name": {"$ref": "#/components/schemas/ClusterName"}}},
+      "ClusterName": {"type": "string"},
+      "RateLimit": {"type": "integer"}
+    }
+  }
+}`
+
+func TestOperationSchemaRefs_FilteredSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(componentRefsSpec))
+	require.NoError(t, err)
+
+	// The filters copy the spec without resolving the refs to the components
+	filtered, err := filter.ApplyFilters(spec, filter.NewMetadata(nil, "prod"), filter.FiltersWithoutVersioning)
+	require.NoError(t, err)
+	operation := filtered.Paths.Find("/clusters/{clusterName}").Patch
+	require.Nil(t, operation.Responses.Value("200").Value)
+
+	assert.Equal(t, []SchemaRef{
+		{Name: "ApiError", Location: ResponseLocation},
+		{Name: "Cluster", Location: RequestLocation},
+		{Name: "Cluster", Location: ResponseLocation},
+		{Name: "ClusterName", Location: RequestLocation},
+		{Name: "RateLimit", Location: ResponseLocation},
+	}, OperationSchemaRefs(filtered, operation))
+
+	g, err := New(filtered)
+	require.NoError(t, err)
+	assert.Equal(t, []*Edge{
+		{From: "operation:updateCluster", To: "schema:ApiError", Location: ResponseLocation},
+	}, g.OperationEdgesTo("ApiError"))
+}
+
+func TestOperationSchemaRefs_RefCycle(t *testing.T) {
+	spec := &openapi3.T{
+		Components: &openapi3.Components{
+			Responses: openapi3.ResponseBodies{
+				"a": &openapi3.ResponseRef{Ref: "#/components/responses/b"},
+				"b": &openapi3.ResponseRef{Ref: "#/components/responses/a"},
+			},
+		},
+	}
+	responses := openapi3.NewResponses()
+	responses.Set("200", &openapi3.ResponseRef{Ref: "#/components/responses/a"})
+
+	assert.Empty(t, OperationSchemaRefs(spec, &openapi3.Operation{Responses: responses}))
+}
+
+func TestGraph_OperationEdgesTo(t *testing.T) {
+	g, err := New(newGraphTestSpec())
+	require.NoError(t, err)
+
+	t.Run("transitive", func(t *testing.T) {
+		assert.Equal(t, []*Edge{
+			{From: "operation:PATCH /clusters/{clusterName}", To: "schema:Provider", Location: RequestLocation},
+			{From: "operation:PATCH /clusters/{clusterName}", To: "schema:Provider", Location: ResponseLocation},
+			{From: "operation:getCluster", To: "schema:Provider", Location: ResponseLocation},
+		}, g.OperationEdgesTo("Provider"))
+	})
+
+	t.Run("direct and transitive", func(t *testing.T) {
+		assert.Equal(t, []*Edge{
+			{From: "operation:PATCH /clusters/{clusterName}", To: "schema:ClusterName", Location: RequestLocation},
+			{From: "operation:PATCH /clusters/{clusterName}", To: "schema:ClusterName", Location: ResponseLocation},
+			{From: "operation:getCluster", To: "schema:ClusterName", Location: ResponseLocation},
+			{From: "operation:getCluster", To: "schema:ClusterName", Location: RequestLocation},
+		}, g.OperationEdgesTo("ClusterName"))
+	})
+
+	t.Run("unreferenced", func(t *testing.T) {
+		assert.Empty(t, g.OperationEdgesTo("Unused"))
+	})
+}
+
+func TestGraph_Node(t *testing.T) {
+	g, err := New(newGraphTestSpec())
+	require.NoError(t, err)
+
+	node := g.Node("operation:getCluster")
+	require.NotNil(t, node)
+	assert.Equal(t, "GET", node.Method)
+	assert.Equal(t, "/clusters/{clusterName}", node.Path)
+	assert.Nil(t, g.Node("schema:Unused"))
+}
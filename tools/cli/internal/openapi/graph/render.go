@@ -0,0 +1,89 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DOT     = "dot"
+	Mermaid = "mermaid"
+	JSON    = "json"
+)
+
+// DOT renders the graph in the Graphviz DOT language.
+// Operations are drawn as boxes and schemas as ellipses.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph openapi {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		shape := "ellipse"
+		if node.Kind == OperationNode {
+			shape = "box"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", strconv.Quote(node.ID), strconv.Quote(node.Label), shape)
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Location == "" {
+			fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(edge.From), strconv.Quote(edge.To))
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(edge.Location))
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart.
+// Mermaid node IDs are generated from the node position, as OpenAPI names can contain unsupported characters.
+func (g *Graph) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	ids := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		id := "n" + strconv.Itoa(i)
+		ids[node.ID] = id
+
+		label := mermaidLabel(node.Label)
+		if node.Kind == OperationNode {
+			fmt.Fprintf(&b, "  %s([%s])\n", id, label)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s[%s]\n", id, label)
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Location == "" {
+			fmt.Fprintf(&b, "  %s --> %s\n", ids[edge.From], ids[edge.To])
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", ids[edge.From], edge.Location, ids[edge.To])
+	}
+
+	return b.String()
+}
+
+// mermaidLabel quotes the label, escaping the characters not allowed in Mermaid strings.
+func mermaidLabel(label string) string {
+	return `"` + strings.ReplaceAll(label, `"`, "#quot;") + `"`
+}
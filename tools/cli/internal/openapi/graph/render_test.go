@@ -0,0 +1,62 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRenderTestGraph() *Graph {
+	return &Graph{
+		Nodes: []*Node{
+			{ID: "operation:getCluster", Kind: OperationNode, Label: "getCluster", Method: "GET", Path: "/clusters"},
+			{ID: "schema:Cluster", Kind: SchemaNode, Label: "Cluster"},
+			{ID: "schema:Provider", Kind: SchemaNode, Label: "Provider"},
+		},
+		Edges: []*Edge{
+			{From: "operation:getCluster", To: "schema:Cluster", Location: ResponseLocation},
+			{From: "schema:Cluster", To: "schema:Provider"},
+		},
+	}
+}
+
+func TestGraph_DOT(t *testing.T) {
+	expected := `digraph openapi {
+  rankdir=LR;
+  "operation:getCluster" [label="getCluster", shape=box];
+  "schema:Cluster" [label="Cluster", shape=ellipse];
+  "schema:Provider" [label="Provider", shape=ellipse];
+  "operation:getCluster" -> "schema:Cluster" [label="response"];
+  "schema:Cluster" -> "schema:Provider";
+}
+`
+	assert.Equal(t, expected, newRenderTestGraph().DOT())
+}
+
+func TestGraph_Mermaid(t *testing.T) {
+	expected := `flowchart LR
+  n0(["getCluster"])
+  n1["Cluster"]
+  n2["Provider"]
+  n0 -->|response| n1
+  n1 --> n2
+`
+	assert.Equal(t, expected, newRenderTestGraph().Mermaid())
+}
+
+func TestMermaidLabel(t *testing.T) {
+	assert.Equal(t, `"say #quot;hi#quot;"`, mermaidLabel(`say "hi"`))
+}
@@ -0,0 +1,275 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lifecycle verifies that the version and sunset dates of an OpenAPI spec follow the API lifecycle policy.
+package lifecycle
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/sunset"
+)
+
+const (
+	sunsetExtension   = "x-sunset"
+	sunsetToBeDecided = "9999-12-31"
+
+	// DefaultMinSunsetDays is the minimum number of days between a version and the sunset of the version it supersedes.
+	DefaultMinSunsetDays = 365
+)
+
+// Rules reported by the linter.
+const (
+	MissingSunsetRule         = "missing-sunset"
+	InvalidSunsetRule         = "invalid-sunset"
+	SunsetTooEarlyRule        = "sunset-too-early"
+	SunsetBeforeVersionRule   = "sunset-before-version"
+	UpcomingInPastRule        = "upcoming-in-past"
+	PlaceholderNotAllowedRule = "placeholder-not-allowed"
+)
+
+// Violation is a lifecycle policy violation found in the spec.
+type Violation struct {
+	Rule        string `json:"rule" yaml:"rule"`
+	Pointer     string `json:"pointer" yaml:"pointer"`
+	OperationID string `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Message     string `json:"message" yaml:"message"`
+}
+
+// Config holds the policy settings of the linter.
+type Config struct {
+	// MinSunsetDays is the minimum number of days between the superseding version date and the sunset date.
+	MinSunsetDays int
+	// Now is the date used to verify that upcoming versions are in the future.
+	Now time.Time
+}
+
+// Lint verifies the lifecycle policy of the spec and returns the violations sorted by JSON pointer.
+// The policy requires that:
+//   - Every content version of a 2xx response superseded by a newer version with the same format has an x-sunset
+//     at least MinSunsetDays after the date of the superseding version.
+//   - No x-sunset is earlier than the date of its own version.
+//   - Upcoming versions have dates after Now.
+//   - The 9999-12-31 placeholder is only used on 2xx responses and operations, where it is removed by the filters.
+func Lint(oas *openapi3.T, config Config) []*Violation {
+	l := &linter{config: config, visited: make(map[string]bool)}
+	if oas == nil || oas.Paths == nil {
+		return nil
+	}
+
+	for path, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for method, op := range pathItem.Operations() {
+			l.lintOperation(pointer("", "paths", path, strings.ToLower(method)), op)
+		}
+	}
+
+	slices.SortFunc(l.violations, func(a, b *Violation) int {
+		return cmp.Or(cmp.Compare(a.Pointer, b.Pointer), cmp.Compare(a.Rule, b.Rule))
+	})
+	return l.violations
+}
+
+type linter struct {
+	config     Config
+	violations []*Violation
+	// visited holds the pointers of the contents already linted, contents can be shared through references
+	visited map[string]bool
+}
+
+func (l *linter) lintOperation(opPointer string, op *openapi3.Operation) {
+	if op == nil {
+		return
+	}
+
+	if value, ok := op.Extensions[sunsetExtension]; ok {
+		version, _ := apiversion.OperationVersion(op)
+		l.lintSunset(pointer(opPointer, sunsetExtension), op.OperationID, value, version, true)
+	}
+
+	if op.Responses != nil {
+		for code, response := range op.Responses.Map() {
+			if response == nil || response.Value == nil {
+				continue
+			}
+
+			contentPointer := pointer(opPointer, "responses", code, "content")
+			if response.Ref != "" {
+				contentPointer = pointer(refPointer(response.Ref), "content")
+			}
+			l.lintContent(contentPointer, op.OperationID, response.Value.Content, strings.HasPrefix(code, "2"))
+		}
+	}
+
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		contentPointer := pointer(opPointer, "requestBody", "content")
+		if op.RequestBody.Ref != "" {
+			contentPointer = pointer(refPointer(op.RequestBody.Ref), "content")
+		}
+		l.lintContent(contentPointer, op.OperationID, op.RequestBody.Value.Content, false)
+	}
+}
+
+// lintContent lints the content types of a request body or response.
+// Deprecation is only verified for success responses, which hold the sunset of the operation versions.
+func (l *linter) lintContent(contentPointer, operationID string, content openapi3.Content, successResponse bool) {
+	if l.visited[contentPointer] {
+		return
+	}
+	l.visited[contentPointer] = true
+
+	for _, contentType := range slices.Sorted(maps.Keys(content)) {
+		mediaType := content[contentType]
+		if mediaType == nil {
+			continue
+		}
+
+		version, err := apiversion.New(apiversion.WithFullContent(contentType, mediaType))
+		if err != nil {
+			continue
+		}
+
+		mediaTypePointer := pointer(contentPointer, contentType)
+		if version.IsUpcoming() && !version.Date().After(l.config.Now) {
+			l.add(UpcomingInPastRule, mediaTypePointer, operationID,
+				"upcoming version %s is not in the future", version)
+		}
+
+		sunsetValue, hasSunset := mediaType.Extensions[sunsetExtension]
+		if hasSunset {
+			l.lintSunset(pointer(mediaTypePointer, sunsetExtension), operationID, sunsetValue, version, successResponse)
+		}
+
+		if !successResponse || !version.IsStable() {
+			continue
+		}
+
+		superseding := supersedingVersion(content, contentType, version)
+		if superseding == nil {
+			continue
+		}
+
+		if !hasSunset {
+			l.add(MissingSunsetRule, mediaTypePointer, operationID,
+				"version %s is superseded by %s but has no %s", version, superseding, sunsetExtension)
+			continue
+		}
+
+		sunsetDate, err := sunsetAsDate(sunsetValue)
+		if err != nil {
+			continue
+		}
+
+		minSunsetDate := superseding.Date().AddDate(0, 0, l.config.MinSunsetDays)
+		if sunsetDate.Before(minSunsetDate) {
+			l.add(SunsetTooEarlyRule, pointer(mediaTypePointer, sunsetExtension), operationID,
+				"sunset %s is less than %d days after the superseding version %s",
+				sunsetDate.Format(time.DateOnly), l.config.MinSunsetDays, superseding)
+		}
+	}
+}
+
+// lintSunset lints a x-sunset value against its own version.
+func (l *linter) lintSunset(sunsetPointer, operationID string, sunsetValue any, version *apiversion.APIVersion, placeholderAllowed bool) {
+	if sunsetValue == sunsetToBeDecided && !placeholderAllowed {
+		l.add(PlaceholderNotAllowedRule, sunsetPointer, operationID,
+			"the %s placeholder is only allowed on success responses and operations", sunsetToBeDecided)
+	}
+
+	sunsetDate, err := sunsetAsDate(sunsetValue)
+	if err != nil {
+		l.add(InvalidSunsetRule, sunsetPointer, operationID, "%v", err)
+		return
+	}
+
+	if version != nil && !version.IsPreview() && sunsetDate.Before(version.Date()) {
+		l.add(SunsetBeforeVersionRule, sunsetPointer, operationID,
+			"sunset %s is before the version date %s", sunsetDate.Format(time.DateOnly), version)
+	}
+}
+
+func (l *linter) add(rule, ptr, operationID, format string, args ...any) {
+	l.violations = append(l.violations, &Violation{
+		Rule:        rule,
+		Pointer:     ptr,
+		OperationID: operationID,
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+// supersedingVersion returns the oldest stable version newer than the given version with the same format,
+// for example application/vnd.atlas.2024-08-05+json supersedes application/vnd.atlas.2023-01-01+json.
+func supersedingVersion(content openapi3.Content, contentType string, version *apiversion.APIVersion) *apiversion.APIVersion {
+	var superseding *apiversion.APIVersion
+	for otherContentType, mediaType := range content {
+		if mediaType == nil || contentFormat(otherContentType) != contentFormat(contentType) {
+			continue
+		}
+
+		otherVersion, err := apiversion.New(apiversion.WithFullContent(otherContentType, mediaType))
+		if err != nil || !otherVersion.IsStable() || !otherVersion.GreaterThan(version) {
+			continue
+		}
+
+		if superseding == nil || otherVersion.LessThan(superseding) {
+			superseding = otherVersion
+		}
+	}
+
+	return superseding
+}
+
+// contentFormat returns the format suffix of the content type, for example json for application/vnd.atlas.2023-01-01+json.
+func contentFormat(contentType string) string {
+	_, format, _ := strings.Cut(contentType, "+")
+	return format
+}
+
+func sunsetAsDate(value any) (time.Time, error) {
+	date, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s must be a date (YYYY-MM-DD) or a RFC3339 timestamp, got %v", sunsetExtension, value)
+	}
+
+	return sunset.ParseDate(date)
+}
+
+// refPointer converts a local reference such as #/components/responses/Cluster to a JSON pointer.
+func refPointer(ref string) string {
+	return strings.TrimPrefix(ref, "#")
+}
+
+// pointer appends the escaped tokens to a JSON pointer as defined in RFC 6901.
+func pointer(base string, tokens ...string) string {
+	var b strings.Builder
+	b.WriteString(base)
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(escapeToken(token))
+	}
+	return b.String()
+}
+
+func escapeToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
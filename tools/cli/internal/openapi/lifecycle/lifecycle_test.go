@@ -0,0 +1,184 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+var config = Config{
+	MinSunsetDays: DefaultMinSunsetDays,
+	Now:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func newSpec(responseContent, requestContent openapi3.Content) *openapi3.T {
+	responses := openapi3.NewResponses()
+	responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{Content: responseContent}})
+
+	op := &openapi3.Operation{OperationID: "getCluster", Responses: responses}
+	if requestContent != nil {
+		op.RequestBody = &openapi3.RequestBodyRef{Ref: "#/components/requestBodies/Cluster", Value: &openapi3.RequestBody{Content: requestContent}}
+	}
+
+	paths := openapi3.NewPaths()
+	paths.Set("/clusters/{name}", &openapi3.PathItem{Get: op})
+	return &openapi3.T{Paths: paths}
+}
+
+func withSunset(date string) *openapi3.MediaType {
+	return &openapi3.MediaType{Extensions: map[string]any{"x-sunset": date}}
+}
+
+func TestLint(t *testing.T) {
+	const contentPointer = "/paths/~1clusters~1{name}/get/responses/200/content/"
+
+	testCases := []struct {
+		name            string
+		responseContent openapi3.Content
+		requestContent  openapi3.Content
+		want            []*Violation
+	}{
+		{
+			name: "valid",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json":          withSunset("2025-08-05"),
+				"application/vnd.atlas.2023-01-01+csv":           {},
+				"application/vnd.atlas.2024-08-05+json":          {},
+				"application/vnd.atlas.2026-03-01.upcoming+json": {},
+			},
+		},
+		{
+			name: "RFC3339 sunset",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": withSunset("2025-08-05T00:00:00Z"),
+				"application/vnd.atlas.2024-08-05+json": {},
+			},
+		},
+		{
+			name: "placeholder on success response",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": withSunset("9999-12-31"),
+				"application/vnd.atlas.2024-08-05+json": {},
+			},
+		},
+		{
+			name: "missing sunset",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": {},
+				"application/vnd.atlas.2024-08-05+json": {},
+			},
+			want: []*Violation{
+				{
+					Rule:        MissingSunsetRule,
+					Pointer:     contentPointer + "application~1vnd.atlas.2023-01-01+json",
+					OperationID: "getCluster",
+					Message:     "version 2023-01-01 is superseded by 2024-08-05 but has no x-sunset",
+				},
+			},
+		},
+		{
+			name: "sunset too early",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": withSunset("2025-01-01"),
+				"application/vnd.atlas.2024-08-05+json": {},
+				"application/vnd.atlas.2025-01-01+json": {},
+			},
+			want: []*Violation{
+				{
+					Rule:        SunsetTooEarlyRule,
+					Pointer:     contentPointer + "application~1vnd.atlas.2023-01-01+json/x-sunset",
+					OperationID: "getCluster",
+					Message:     "sunset 2025-01-01 is less than 365 days after the superseding version 2024-08-05",
+				},
+				{
+					Rule:        MissingSunsetRule,
+					Pointer:     contentPointer + "application~1vnd.atlas.2024-08-05+json",
+					OperationID: "getCluster",
+					Message:     "version 2024-08-05 is superseded by 2025-01-01 but has no x-sunset",
+				},
+			},
+		},
+		{
+			name: "sunset before version and upcoming in past",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2024-08-05+json":          withSunset("2024-01-01"),
+				"application/vnd.atlas.2025-06-01.upcoming+json": {},
+			},
+			want: []*Violation{
+				{
+					Rule:        SunsetBeforeVersionRule,
+					Pointer:     contentPointer + "application~1vnd.atlas.2024-08-05+json/x-sunset",
+					OperationID: "getCluster",
+					Message:     "sunset 2024-01-01 is before the version date 2024-08-05",
+				},
+				{
+					Rule:        UpcomingInPastRule,
+					Pointer:     contentPointer + "application~1vnd.atlas.2025-06-01.upcoming+json",
+					OperationID: "getCluster",
+					Message:     "upcoming version 2025-06-01.upcoming is not in the future",
+				},
+			},
+		},
+		{
+			name: "placeholder and invalid sunset on request body",
+			responseContent: openapi3.Content{
+				"application/vnd.atlas.2024-08-05+json": {},
+			},
+			requestContent: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": withSunset("9999-12-31"),
+				"application/vnd.atlas.2024-08-05+json": withSunset("next year"),
+			},
+			want: []*Violation{
+				{
+					Rule:        PlaceholderNotAllowedRule,
+					Pointer:     "/components/requestBodies/Cluster/content/application~1vnd.atlas.2023-01-01+json/x-sunset",
+					OperationID: "getCluster",
+					Message:     "the 9999-12-31 placeholder is only allowed on success responses and operations",
+				},
+				{
+					Rule:        InvalidSunsetRule,
+					Pointer:     "/components/requestBodies/Cluster/content/application~1vnd.atlas.2024-08-05+json/x-sunset",
+					OperationID: "getCluster",
+					Message:     `x-sunset must be a date (YYYY-MM-DD) or a RFC3339 timestamp, got "next year"`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Lint(newSpec(tc.responseContent, tc.requestContent), config))
+		})
+	}
+}
+
+func TestLint_MinSunsetDays(t *testing.T) {
+	spec := newSpec(openapi3.Content{
+		"application/vnd.atlas.2023-01-01+json": withSunset("2025-01-01"),
+		"application/vnd.atlas.2024-08-05+json": {},
+	}, nil)
+
+	assert.Len(t, Lint(spec, config), 1)
+	assert.Empty(t, Lint(spec, Config{MinSunsetDays: 90, Now: config.Now}))
+}
+
+func TestPointer(t *testing.T) {
+	assert.Equal(t, "/paths/~1a~0b/get", pointer("", "paths", "/a~b", "get"))
+	assert.Equal(t, "/components/responses/Cluster/content", pointer(refPointer("#/components/responses/Cluster"), "content"))
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/filter"
+)
+
+// Availability statuses of an operation in an API version.
+const (
+	AvailableStatus  = "available"
+	DeprecatedStatus = "deprecated"
+	SunsettingStatus = "sunsetting"
+	PreviewStatus    = "preview"
+	UpcomingStatus   = "upcoming"
+
+	sunsetExtension = "x-sunset"
+)
+
+// VersionMatrix is the availability of each operation in each API version.
+type VersionMatrix struct {
+	Versions   []string                 `json:"versions" yaml:"versions"`
+	Operations []*OperationAvailability `json:"operations" yaml:"operations"`
+}
+
+// OperationAvailability is the status of an operation per API version.
+// Versions where the operation is not available are not included.
+type OperationAvailability struct {
+	OperationID string            `json:"operationId" yaml:"operationId"`
+	Method      string            `json:"method" yaml:"method"`
+	Path        string            `json:"path" yaml:"path"`
+	Versions    map[string]string `json:"versions" yaml:"versions"`
+}
+
+// NewVersionMatrix computes the availability of each operation for all the API versions of the spec.
+// When env is set, the content hidden for the environment is not considered.
+func NewVersionMatrix(oas *openapi3.T, env string) (*VersionMatrix, error) {
+	doc := oas
+	if env != "" {
+		var err error
+		doc, err = filter.ApplyFilters(oas, filter.NewMetadata(nil, env), filter.FiltersToGetVersions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	versions, err := extractVersions(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersions := make([]*apiversion.APIVersion, 0, len(versions))
+	for _, version := range versions {
+		apiVersion, err := apiversion.New(apiversion.WithVersion(version))
+		if err != nil {
+			return nil, err
+		}
+		apiVersions = append(apiVersions, apiVersion)
+	}
+
+	matrix := &VersionMatrix{
+		Versions:   versions,
+		Operations: []*OperationAvailability{},
+	}
+
+	for path, pathItem := range doc.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for method, operation := range pathItem.Operations() {
+			if operation == nil {
+				continue
+			}
+
+			availability := &OperationAvailability{
+				OperationID: operation.OperationID,
+				Method:      method,
+				Path:        path,
+				Versions:    make(map[string]string),
+			}
+			for _, apiVersion := range apiVersions {
+				if status := OperationStatus(operation, apiVersion); status != "" {
+					availability.Versions[apiVersion.String()] = status
+				}
+			}
+			matrix.Operations = append(matrix.Operations, availability)
+		}
+	}
+
+	slices.SortFunc(matrix.Operations, func(a, b *OperationAvailability) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+
+	return matrix, nil
+}
+
+// OperationStatus returns the availability status of the operation in the requested version,
+// or an empty string if the operation is not available in that version.
+// The status is based on the latest content version matched by the requested version.
+func OperationStatus(operation *openapi3.Operation, requestedVersion *apiversion.APIVersion) string {
+	matchedVersion := apiversion.FindLatestContentVersionMatched(operation, requestedVersion)
+	contents := matchedVersionContents(operation, matchedVersion)
+	if len(contents) == 0 {
+		return ""
+	}
+
+	switch {
+	case matchedVersion.IsUpcoming():
+		return UpcomingStatus
+	case matchedVersion.IsPreview():
+		return PreviewStatus
+	}
+
+	for _, content := range contents {
+		if content.Content == nil {
+			continue
+		}
+		if _, ok := content.Content.Extensions[sunsetExtension]; ok {
+			return SunsettingStatus
+		}
+	}
+
+	if operation.Deprecated {
+		return DeprecatedStatus
+	}
+
+	return AvailableStatus
+}
+
+// matchedVersionContents returns the content versions of the operation equal to the given version,
+// see apiversion.OperationContentVersions.
+func matchedVersionContents(operation *openapi3.Operation, version *apiversion.APIVersion) []apiversion.ContentVersion {
+	var contents []apiversion.ContentVersion
+	for _, content := range apiversion.OperationContentVersions(operation) {
+		if content.Version.Equal(version) {
+			contents = append(contents, content)
+		}
+	}
+
+	return contents
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionMatrix(t *testing.T) {
+	matrix, err := NewVersionMatrix(NewVersionedResponses(t), "dev")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2023-01-01", "2023-02-01", "preview", "private-preview-info-resource"}, matrix.Versions)
+
+	statuses := make(map[string]map[string]string)
+	for _, operation := range matrix.Operations {
+		statuses[operation.Method+" "+operation.Path] = operation.Versions
+	}
+	assert.Equal(t, map[string]string{"2023-01-01": AvailableStatus, "2023-02-01": AvailableStatus}, statuses["GET pathBase1"])
+	assert.Equal(t, map[string]string{"2023-02-01": AvailableStatus}, statuses["PUT pathBase2"])
+	assert.Equal(t, map[string]string{"private-preview-info-resource": PreviewStatus}, statuses["DELETE pathBase3"])
+	assert.Equal(t, map[string]string{"preview": PreviewStatus}, statuses["POST pathBase4"])
+}
+
+func TestNewVersionMatrix_HiddenEnv(t *testing.T) {
+	matrix, err := NewVersionMatrix(NewVersionedResponses(t), "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2023-01-01", "2023-02-01"}, matrix.Versions)
+}
+
+func TestOperationStatus(t *testing.T) {
+	newOperation := func(deprecated bool, contents openapi3.Content) *openapi3.Operation {
+		responses := openapi3.NewResponses()
+		responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{Content: contents}})
+		return &openapi3.Operation{Deprecated: deprecated, Responses: responses}
+	}
+
+	testCases := []struct {
+		name      string
+		operation *openapi3.Operation
+		version   string
+		expected  string
+	}{
+		{
+			name:      "available",
+			operation: newOperation(false, openapi3.Content{"application/vnd.atlas.2023-01-01+json": {}}),
+			version:   "2024-01-01",
+			expected:  AvailableStatus,
+		},
+		{
+			name:      "not available before the first version",
+			operation: newOperation(false, openapi3.Content{"application/vnd.atlas.2024-01-01+json": {}}),
+			version:   "2023-01-01",
+			expected:  "",
+		},
+		{
+			name:      "deprecated",
+			operation: newOperation(true, openapi3.Content{"application/vnd.atlas.2023-01-01+json": {}}),
+			version:   "2023-01-01",
+			expected:  DeprecatedStatus,
+		},
+		{
+			name: "sunsetting",
+			operation: newOperation(false, openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": {Extensions: map[string]any{"x-sunset": "2025-01-01"}},
+				"application/vnd.atlas.2024-01-01+json": {},
+			}),
+			version:  "2023-06-01",
+			expected: SunsettingStatus,
+		},
+		{
+			name: "newer version without sunset",
+			operation: newOperation(false, openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": {Extensions: map[string]any{"x-sunset": "2025-01-01"}},
+				"application/vnd.atlas.2024-01-01+json": {},
+			}),
+			version:  "2024-01-01",
+			expected: AvailableStatus,
+		},
+		{
+			name:      "upcoming",
+			operation: newOperation(false, openapi3.Content{"application/vnd.atlas.2025-01-01.upcoming+json": {}}),
+			version:   "2025-01-01.upcoming",
+			expected:  UpcomingStatus,
+		},
+		{
+			name: "public preview",
+			operation: newOperation(false, openapi3.Content{
+				"application/vnd.atlas.preview+json": {Extensions: map[string]any{"x-xgen-preview": map[string]any{"public": "true"}}},
+			}),
+			version:  "preview",
+			expected: PreviewStatus,
+		},
+		{
+			name: "preview not available in stable version",
+			operation: newOperation(false, openapi3.Content{
+				"application/vnd.atlas.preview+json": {Extensions: map[string]any{"x-xgen-preview": map[string]any{"public": "true"}}},
+			}),
+			version:  "2024-01-01",
+			expected: "",
+		},
+		{
+			name: "request body version",
+			operation: &openapi3.Operation{
+				RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{
+					Content: openapi3.Content{"application/vnd.atlas.2023-01-01+json": {}},
+				}},
+				Responses: openapi3.NewResponses(),
+			},
+			version:  "2024-01-01",
+			expected: AvailableStatus,
+		},
+		{
+			name: "operation version",
+			operation: &openapi3.Operation{
+				Extensions: map[string]any{apiversion.VersionExtension: "2023-01-01"},
+				Responses:  openapi3.NewResponses(),
+			},
+			version:  "2024-01-01",
+			expected: AvailableStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := apiversion.New(apiversion.WithVersion(tc.version))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, OperationStatus(tc.operation, version))
+		})
+	}
+}
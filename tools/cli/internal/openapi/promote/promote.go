@@ -0,0 +1,202 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+)
+
+const upcomingSuffix = "." + apiversion.UpcomingStabilityLevel
+
+// Operation is an operation promoted from the upcoming to the stable version.
+// ContentTypes are the upcoming content types of the operation, and is empty if the operation is only
+// versioned by its x-xgen-version extension.
+type Operation struct {
+	OperationID  string
+	Method       string
+	Path         string
+	ContentTypes []string
+}
+
+// Upcoming promotes the upcoming version, e.g. 2026-01-15.upcoming, to the stable version with the same date.
+// The upcoming content types are renamed to the stable content types, e.g. application/vnd.atlas.2026-01-15.upcoming+json
+// to application/vnd.atlas.2026-01-15+json, and the x-xgen-version extensions are updated accordingly.
+// It fails without modifying the spec if stable content with the same date already exists, or if no operation
+// has the upcoming version.
+func Upcoming(oas *openapi3.T, upcomingVersion string) ([]*Operation, error) {
+	if oas == nil {
+		return nil, errors.New("OpenAPI spec is nil")
+	}
+
+	version, err := apiversion.New(apiversion.WithVersion(upcomingVersion))
+	if err != nil {
+		return nil, err
+	}
+	if !version.IsUpcoming() {
+		return nil, fmt.Errorf("version %q is not an upcoming version", upcomingVersion)
+	}
+	stableVersion := strings.TrimSuffix(version.String(), upcomingSuffix)
+
+	contents := specContents(oas)
+	if conflicts := findConflicts(contents, stableVersion); len(conflicts) > 0 {
+		return nil, fmt.Errorf("stable version %q already exists in: %s", stableVersion, strings.Join(conflicts, ", "))
+	}
+
+	operations := scanOperations(oas, version.String())
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no operations found for the upcoming version %q", version.String())
+	}
+
+	for _, c := range contents {
+		promoteContent(c.content, version.String(), stableVersion)
+	}
+
+	for _, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for _, operation := range pathItem.Operations() {
+			promoteVersionExtension(operation.Extensions, version.String(), stableVersion)
+		}
+	}
+
+	log.Printf("Promoted %d operations from %q to %q", len(operations), version.String(), stableVersion)
+	return operations, nil
+}
+
+// scanOperations returns the operations with upcoming content or with the upcoming x-xgen-version extension.
+func scanOperations(oas *openapi3.T, upcomingVersion string) []*Operation {
+	if oas.Paths == nil {
+		return nil
+	}
+
+	var operations []*Operation
+	for path, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for method, operation := range pathItem.Operations() {
+			var contentTypes []string
+			for _, c := range apiversion.ContentVersions(apiversion.OperationContents(operation)...) {
+				if c.Version.String() == upcomingVersion {
+					contentTypes = append(contentTypes, c.ContentType)
+				}
+			}
+
+			operationVersion, _ := operation.Extensions[apiversion.VersionExtension].(string)
+			if len(contentTypes) == 0 && operationVersion != upcomingVersion {
+				continue
+			}
+
+			slices.Sort(contentTypes)
+			operations = append(operations, &Operation{
+				OperationID:  operation.OperationID,
+				Method:       method,
+				Path:         path,
+				ContentTypes: slices.Compact(contentTypes),
+			})
+		}
+	}
+
+	slices.SortFunc(operations, func(a, b *Operation) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+	return operations
+}
+
+// findConflicts returns the sorted locations of the contents that already have the stable version.
+func findConflicts(contents []*specContent, stableVersion string) []string {
+	var conflicts []string
+	for _, c := range contents {
+		if slices.ContainsFunc(apiversion.ContentVersions(c.content), func(v apiversion.ContentVersion) bool {
+			return v.Version.String() == stableVersion
+		}) {
+			conflicts = append(conflicts, c.location)
+		}
+	}
+
+	slices.Sort(conflicts)
+	return slices.Compact(conflicts)
+}
+
+// specContent is a content of the spec with its location, e.g. "PATCH /clusters" or "#/components/responses/Cluster".
+type specContent struct {
+	location string
+	content  openapi3.Content
+}
+
+// specContents returns the contents of the operations and of the response and request body components.
+func specContents(oas *openapi3.T) []*specContent {
+	var contents []*specContent
+	if oas.Paths != nil {
+		for path, pathItem := range oas.Paths.Map() {
+			if pathItem == nil {
+				continue
+			}
+			for method, operation := range pathItem.Operations() {
+				for _, content := range apiversion.OperationContents(operation) {
+					contents = append(contents, &specContent{location: method + " " + path, content: content})
+				}
+			}
+		}
+	}
+
+	if oas.Components == nil {
+		return contents
+	}
+
+	for name, response := range oas.Components.Responses {
+		if response != nil && response.Value != nil && response.Value.Content != nil {
+			contents = append(contents, &specContent{location: "#/components/responses/" + name, content: response.Value.Content})
+		}
+	}
+
+	for name, requestBody := range oas.Components.RequestBodies {
+		if requestBody != nil && requestBody.Value != nil && requestBody.Value.Content != nil {
+			contents = append(contents, &specContent{location: "#/components/requestBodies/" + name, content: requestBody.Value.Content})
+		}
+	}
+
+	return contents
+}
+
+// promoteContent renames the upcoming content types of the content to the stable content types.
+func promoteContent(content openapi3.Content, upcomingVersion, stableVersion string) {
+	for _, c := range apiversion.ContentVersions(content) {
+		if c.Version.String() != upcomingVersion {
+			continue
+		}
+
+		stableContentType := strings.Replace(c.ContentType, upcomingVersion, stableVersion, 1)
+		log.Printf("Renaming content type %q to %q", c.ContentType, stableContentType)
+		delete(content, c.ContentType)
+		content[stableContentType] = c.Content
+		promoteVersionExtension(c.Content.Extensions, upcomingVersion, stableVersion)
+	}
+}
+
+func promoteVersionExtension(extensions map[string]any, upcomingVersion, stableVersion string) {
+	if value, ok := extensions[apiversion.VersionExtension].(string); ok && value == upcomingVersion {
+		extensions[apiversion.VersionExtension] = stableVersion
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newUpcomingSpec() *openapi3.T {
+	sharedResponse := &openapi3.Response{
+		Content: openapi3.Content{
+			"application/vnd.atlas.2024-01-01+json": {},
+			"application/vnd.atlas.2026-01-15.upcoming+json": {
+				Extensions: map[string]any{"x-xgen-version": "2026-01-15.upcoming"},
+			},
+		},
+	}
+
+	getResponses := openapi3.NewResponses()
+	getResponses.Set("200", &openapi3.ResponseRef{Ref: "#/components/responses/Cluster", Value: sharedResponse})
+
+	patchResponses := openapi3.NewResponses()
+	patchResponses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/clusters", &openapi3.PathItem{
+		Get: &openapi3.Operation{OperationID: "getCluster", Responses: getResponses},
+		Patch: &openapi3.Operation{
+			OperationID: "updateCluster",
+			Responses:   patchResponses,
+			RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{
+				Content: openapi3.Content{
+					"application/vnd.atlas.2026-01-15.upcoming+json": {},
+				},
+			}},
+		},
+	})
+	paths.Set("/info", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "getInfo",
+			Responses:   openapi3.NewResponses(),
+			Extensions:  map[string]any{"x-xgen-version": "2026-01-15.upcoming"},
+		},
+	})
+
+	return &openapi3.T{
+		Paths: paths,
+		Components: &openapi3.Components{
+			Responses: openapi3.ResponseBodies{
+				"Cluster": &openapi3.ResponseRef{Value: sharedResponse},
+			},
+		},
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	spec := newUpcomingSpec()
+	operations, err := Upcoming(spec, "2026-01-15.upcoming")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*Operation{
+		{OperationID: "getCluster", Method: "GET", Path: "/clusters", ContentTypes: []string{"application/vnd.atlas.2026-01-15.upcoming+json"}},
+		{OperationID: "updateCluster", Method: "PATCH", Path: "/clusters", ContentTypes: []string{"application/vnd.atlas.2026-01-15.upcoming+json"}},
+		{OperationID: "getInfo", Method: "GET", Path: "/info"},
+	}, operations)
+
+	content := spec.Components.Responses["Cluster"].Value.Content
+	assert.Nil(t, content.Get("application/vnd.atlas.2026-01-15.upcoming+json"))
+	require.NotNil(t, content.Get("application/vnd.atlas.2026-01-15+json"))
+	assert.Equal(t, "2026-01-15", content.Get("application/vnd.atlas.2026-01-15+json").Extensions["x-xgen-version"])
+	assert.NotNil(t, content.Get("application/vnd.atlas.2024-01-01+json"))
+
+	requestContent := spec.Paths.Find("/clusters").Patch.RequestBody.Value.Content
+	assert.Len(t, requestContent, 1)
+	assert.NotNil(t, requestContent.Get("application/vnd.atlas.2026-01-15+json"))
+
+	assert.Equal(t, "2026-01-15", spec.Paths.Find("/info").Get.Extensions["x-xgen-version"])
+}
+
+func TestUpcoming_StableVersionExists(t *testing.T) {
+	spec := newUpcomingSpec()
+	spec.Paths.Find("/clusters").Patch.RequestBody.Value.Content["application/vnd.atlas.2026-01-15+json"] = &openapi3.MediaType{}
+
+	_, err := Upcoming(spec, "2026-01-15.upcoming")
+	require.ErrorContains(t, err, `stable version "2026-01-15" already exists in: PATCH /clusters`)
+
+	// the spec is not modified
+	assert.NotNil(t, spec.Components.Responses["Cluster"].Value.Content.Get("application/vnd.atlas.2026-01-15.upcoming+json"))
+}
+
+func TestUpcoming_StableVersionExistsInComponent(t *testing.T) {
+	spec := newUpcomingSpec()
+	spec.Components.RequestBodies = openapi3.RequestBodies{
+		"UnusedCluster": &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{
+			Content: openapi3.Content{
+				"application/vnd.atlas.2026-01-15+json":          {},
+				"application/vnd.atlas.2026-01-15.upcoming+json": {},
+			},
+		}},
+	}
+
+	_, err := Upcoming(spec, "2026-01-15.upcoming")
+	require.ErrorContains(t, err, `stable version "2026-01-15" already exists in: #/components/requestBodies/UnusedCluster`)
+
+	// the spec is not modified
+	assert.Len(t, spec.Components.RequestBodies["UnusedCluster"].Value.Content, 2)
+	assert.NotNil(t, spec.Components.Responses["Cluster"].Value.Content.Get("application/vnd.atlas.2026-01-15.upcoming+json"))
+}
+
+func TestUpcoming_InvalidVersion(t *testing.T) {
+	testCases := []struct {
+		name        string
+		version     string
+		expectedErr string
+	}{
+		{name: "stable version", version: "2026-01-15", expectedErr: "is not an upcoming version"},
+		{name: "invalid version", version: "invalid", expectedErr: "cannot parse"},
+		{name: "missing version", version: "2027-01-01.upcoming", expectedErr: "no operations found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Upcoming(newUpcomingSpec(), tc.version)
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffold
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+)
+
+const sunsetExtension = "x-sunset"
+
+// Operation is an operation with content scaffolded for the new version.
+type Operation struct {
+	OperationID      string
+	Method           string
+	Path             string
+	PreviousVersions []string
+	ContentTypes     []string
+}
+
+// Version scaffolds a new stable version for the operations with the given IDs.
+// For each request body and response of the operations, the content types of the latest stable version before the
+// new version are copied to the new version, and the copied content types are marked for sunset on sunsetDate.
+// Content types that already have an x-sunset keep their sunset date.
+func Version(oas *openapi3.T, version string, operationIDs []string, sunsetDate time.Time) ([]*Operation, error) {
+	if oas == nil {
+		return nil, errors.New("OpenAPI spec is nil")
+	}
+
+	newVersion, err := apiversion.New(apiversion.WithVersion(version))
+	if err != nil {
+		return nil, err
+	}
+	if !newVersion.IsStable() {
+		return nil, fmt.Errorf("version %q is not a stable version", version)
+	}
+
+	if !sunsetDate.After(newVersion.Date()) {
+		return nil, fmt.Errorf("sunset date %s must be after the new version %s", sunsetDate.Format(time.DateOnly), version)
+	}
+
+	operations, err := findOperations(oas, operationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate all operations before modifying the spec, operations can share contents through references
+	latestContents := make([][]*versionedContent, len(operations))
+	for i, operation := range operations {
+		if latestContents[i], err = operationLatestContents(operation, newVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, operation := range operations {
+		scaffoldOperation(operation, latestContents[i], newVersion, sunsetDate)
+	}
+
+	out := make([]*Operation, 0, len(operations))
+	for _, operation := range operations {
+		out = append(out, operation.summary)
+	}
+	slices.SortFunc(out, func(a, b *Operation) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+
+	return out, nil
+}
+
+type operation struct {
+	operation *openapi3.Operation
+	summary   *Operation
+}
+
+// findOperations returns the operations with the given IDs and fails if any ID is not found.
+func findOperations(oas *openapi3.T, operationIDs []string) ([]*operation, error) {
+	var operations []*operation
+	found := make(map[string]bool)
+	for path, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for method, op := range pathItem.Operations() {
+			if op == nil || !slices.Contains(operationIDs, op.OperationID) {
+				continue
+			}
+
+			found[op.OperationID] = true
+			operations = append(operations, &operation{
+				operation: op,
+				summary:   &Operation{OperationID: op.OperationID, Method: method, Path: path},
+			})
+		}
+	}
+
+	var missing []string
+	for _, id := range operationIDs {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("operations not found: %s", strings.Join(missing, ", "))
+	}
+
+	return operations, nil
+}
+
+// versionedContent is a content with the latest stable version to copy.
+type versionedContent struct {
+	content openapi3.Content
+	version *apiversion.APIVersion
+}
+
+// operationLatestContents returns the contents of the operation with their latest stable version before the new version.
+func operationLatestContents(op *operation, newVersion *apiversion.APIVersion) ([]*versionedContent, error) {
+	var contents []*versionedContent
+	for _, content := range apiversion.OperationContents(op.operation) {
+		latest, err := latestStableVersion(content, newVersion)
+		if err != nil {
+			return nil, fmt.Errorf("operation %q: %w", op.summary.OperationID, err)
+		}
+		if latest != nil {
+			contents = append(contents, &versionedContent{content: content, version: latest})
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil, fmt.Errorf("operation %q has no stable version before %q", op.summary.OperationID, newVersion)
+	}
+
+	return contents, nil
+}
+
+func scaffoldOperation(op *operation, contents []*versionedContent, newVersion *apiversion.APIVersion, sunsetDate time.Time) {
+	for _, c := range contents {
+		contentTypes := copyContent(c.content, c.version, newVersion, sunsetDate)
+		op.summary.ContentTypes = append(op.summary.ContentTypes, contentTypes...)
+		if !slices.Contains(op.summary.PreviousVersions, c.version.String()) {
+			op.summary.PreviousVersions = append(op.summary.PreviousVersions, c.version.String())
+		}
+	}
+
+	slices.Sort(op.summary.ContentTypes)
+	op.summary.ContentTypes = slices.Compact(op.summary.ContentTypes)
+	slices.Sort(op.summary.PreviousVersions)
+}
+
+// latestStableVersion returns the latest stable version of the content before the new version, or nil if the
+// content is not versioned. It fails if the content already has the new version.
+func latestStableVersion(content openapi3.Content, newVersion *apiversion.APIVersion) (*apiversion.APIVersion, error) {
+	var latest *apiversion.APIVersion
+	for contentType, mediaType := range content {
+		if mediaType == nil {
+			continue
+		}
+
+		contentVersion, err := apiversion.New(apiversion.WithFullContent(contentType, mediaType))
+		if err != nil {
+			continue
+		}
+
+		if contentVersion.Equal(newVersion) {
+			return nil, fmt.Errorf("content type %q already exists", contentType)
+		}
+
+		if !contentVersion.IsStable() || !contentVersion.LessThan(newVersion) {
+			continue
+		}
+
+		if latest == nil || contentVersion.GreaterThan(latest) {
+			latest = contentVersion
+		}
+	}
+
+	return latest, nil
+}
+
+// copyContent copies the content types of the previous version to the new version and returns the new content types.
+// Content types already copied through another operation sharing the content are not copied again.
+func copyContent(content openapi3.Content, previousVersion, newVersion *apiversion.APIVersion, sunsetDate time.Time) []string {
+	var contentTypes []string
+	for contentType, mediaType := range content {
+		if mediaType == nil {
+			continue
+		}
+
+		contentVersion, err := apiversion.New(apiversion.WithFullContent(contentType, mediaType))
+		if err != nil || !contentVersion.Equal(previousVersion) {
+			continue
+		}
+
+		newContentType := strings.Replace(contentType, previousVersion.String()+"+", newVersion.String()+"+", 1)
+		contentTypes = append(contentTypes, newContentType)
+		if _, ok := content[newContentType]; ok {
+			continue
+		}
+
+		newMediaType := *mediaType
+		newMediaType.Extensions = maps.Clone(mediaType.Extensions)
+		if newMediaType.Extensions == nil {
+			newMediaType.Extensions = make(map[string]any)
+		}
+		newMediaType.Extensions[apiversion.VersionExtension] = newVersion.String()
+		delete(newMediaType.Extensions, sunsetExtension)
+		content[newContentType] = &newMediaType
+		log.Printf("Copied content type %q to %q", contentType, newContentType)
+
+		if mediaType.Extensions == nil {
+			mediaType.Extensions = make(map[string]any)
+		}
+		if _, ok := mediaType.Extensions[sunsetExtension]; !ok {
+			mediaType.Extensions[sunsetExtension] = sunsetDate.Format(time.DateOnly)
+		}
+	}
+
+	return contentTypes
+}
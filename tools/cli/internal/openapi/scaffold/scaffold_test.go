@@ -0,0 +1,166 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffold
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sunsetDate = time.Date(2027, 11, 1, 0, 0, 0, 0, time.UTC)
+
+func newSpec() *openapi3.T {
+	sharedResponse := &openapi3.Response{
+		Content: openapi3.Content{
+			"application/vnd.atlas.2023-01-01+json": {
+				Schema:     &openapi3.SchemaRef{Ref: "#/components/schemas/ClusterV1"},
+				Extensions: map[string]any{"x-sunset": "2026-06-01"},
+			},
+			"application/vnd.atlas.2024-08-05+json": {
+				Schema:     &openapi3.SchemaRef{Ref: "#/components/schemas/Cluster"},
+				Extensions: map[string]any{"x-xgen-version": "2024-08-05"},
+			},
+			"application/vnd.atlas.2026-01-15.upcoming+json": {},
+		},
+	}
+
+	getResponses := openapi3.NewResponses()
+	getResponses.Set("200", &openapi3.ResponseRef{Ref: "#/components/responses/Cluster", Value: sharedResponse})
+
+	patchResponses := openapi3.NewResponses()
+	patchResponses.Set("200", &openapi3.ResponseRef{Ref: "#/components/responses/Cluster", Value: sharedResponse})
+
+	deleteResponses := openapi3.NewResponses()
+	deleteResponses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{
+		Content: openapi3.Content{
+			"application/vnd.atlas.2027-01-01.upcoming+json": {},
+		},
+	}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/clusters", &openapi3.PathItem{
+		Get: &openapi3.Operation{OperationID: "getCluster", Responses: getResponses},
+		Patch: &openapi3.Operation{
+			OperationID: "updateCluster",
+			Responses:   patchResponses,
+			RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{
+				Content: openapi3.Content{
+					"application/vnd.atlas.2023-01-01+json": {},
+				},
+			}},
+		},
+		Delete: &openapi3.Operation{OperationID: "deleteCluster", Responses: deleteResponses},
+	})
+
+	return &openapi3.T{Paths: paths}
+}
+
+func TestVersion(t *testing.T) {
+	spec := newSpec()
+	operations, err := Version(spec, "2026-11-01", []string{"getCluster", "updateCluster"}, sunsetDate)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*Operation{
+		{
+			OperationID:      "getCluster",
+			Method:           "GET",
+			Path:             "/clusters",
+			PreviousVersions: []string{"2024-08-05"},
+			ContentTypes:     []string{"application/vnd.atlas.2026-11-01+json"},
+		},
+		{
+			OperationID:      "updateCluster",
+			Method:           "PATCH",
+			Path:             "/clusters",
+			PreviousVersions: []string{"2023-01-01", "2024-08-05"},
+			ContentTypes:     []string{"application/vnd.atlas.2026-11-01+json"},
+		},
+	}, operations)
+
+	pathItem := spec.Paths.Value("/clusters")
+	content := pathItem.Get.Responses.Value("200").Value.Content
+	require.Contains(t, content, "application/vnd.atlas.2026-11-01+json")
+
+	newContent := content["application/vnd.atlas.2026-11-01+json"]
+	assert.Equal(t, "#/components/schemas/Cluster", newContent.Schema.Ref)
+	assert.Equal(t, map[string]any{"x-xgen-version": "2026-11-01"}, newContent.Extensions)
+	assert.Equal(t, "2027-11-01", content["application/vnd.atlas.2024-08-05+json"].Extensions["x-sunset"])
+	assert.Equal(t, "2026-06-01", content["application/vnd.atlas.2023-01-01+json"].Extensions["x-sunset"])
+
+	requestContent := pathItem.Patch.RequestBody.Value.Content
+	require.Contains(t, requestContent, "application/vnd.atlas.2026-11-01+json")
+	assert.Equal(t, "2027-11-01", requestContent["application/vnd.atlas.2023-01-01+json"].Extensions["x-sunset"])
+
+	assert.Len(t, pathItem.Delete.Responses.Value("204").Value.Content, 1)
+}
+
+func TestVersion_Errors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		version      string
+		operationIDs []string
+		sunsetDate   time.Time
+		wantErr      string
+	}{
+		{
+			name:         "not stable version",
+			version:      "2026-11-01.upcoming",
+			operationIDs: []string{"getCluster"},
+			sunsetDate:   sunsetDate,
+			wantErr:      `version "2026-11-01.upcoming" is not a stable version`,
+		},
+		{
+			name:         "sunset before version",
+			version:      "2026-11-01",
+			operationIDs: []string{"getCluster"},
+			sunsetDate:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantErr:      "sunset date 2026-10-01 must be after the new version 2026-11-01",
+		},
+		{
+			name:         "operations not found",
+			version:      "2026-11-01",
+			operationIDs: []string{"getCluster", "listClusters"},
+			sunsetDate:   sunsetDate,
+			wantErr:      "operations not found: listClusters",
+		},
+		{
+			name:         "no stable version",
+			version:      "2026-11-01",
+			operationIDs: []string{"deleteCluster"},
+			sunsetDate:   sunsetDate,
+			wantErr:      `operation "deleteCluster" has no stable version before "2026-11-01"`,
+		},
+		{
+			name:         "version already exists",
+			version:      "2024-08-05",
+			operationIDs: []string{"getCluster"},
+			sunsetDate:   sunsetDate,
+			wantErr:      `operation "getCluster": content type "application/vnd.atlas.2024-08-05+json" already exists`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := newSpec()
+			_, err := Version(spec, tc.version, tc.operationIDs, tc.sunsetDate)
+			require.EqualError(t, err, tc.wantErr)
+			assert.Len(t, spec.Paths.Value("/clusters").Get.Responses.Value("200").Value.Content, 3)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+	"github.com/mongodb/openapi/tools/cli/internal/openapi/sunset"
+)
+
+const (
+	ownerTeamExtension = "x-xgen-owner-team"
+	sunsetExtension    = "x-sunset"
+)
+
+// newMetadataMatchers returns the matchers for the operation metadata criteria of the selection:
+// owner team, deprecation, stability level, private preview name and sunset date range.
+func newMetadataMatchers(sel *selection) ([]operationMatcher, error) {
+	var matchers []operationMatcher
+
+	if len(sel.ownerTeams) > 0 {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			team := ownerTeam(operation)
+			return slices.ContainsFunc(sel.ownerTeams, func(t string) bool { return strings.EqualFold(t, team) })
+		})
+	}
+
+	if sel.deprecated {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return operation.Deprecated
+		})
+	}
+
+	if len(sel.stabilityLevels) > 0 {
+		for _, level := range sel.stabilityLevels {
+			if err := apiversion.ValidateStabilityLevel(level); err != nil {
+				return nil, err
+			}
+		}
+
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return slices.ContainsFunc(apiversion.ContentVersions(apiversion.OperationContents(operation)...), func(c apiversion.ContentVersion) bool {
+				return slices.ContainsFunc(sel.stabilityLevels, func(level string) bool { return matchesStabilityLevel(c.Version, level) })
+			})
+		})
+	}
+
+	if len(sel.previewNames) > 0 {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return slices.ContainsFunc(apiversion.ContentVersions(apiversion.OperationContents(operation)...), func(c apiversion.ContentVersion) bool {
+				return slices.ContainsFunc(sel.previewNames, func(name string) bool { return matchesPreviewName(c.Version, name) })
+			})
+		})
+	}
+
+	if sel.sunsetFrom != nil || sel.sunsetTo != nil {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return slices.ContainsFunc(sunsetDates(operation), func(date time.Time) bool {
+				return isDateInRange(date, sel.sunsetFrom, sel.sunsetTo)
+			})
+		})
+	}
+
+	return matchers, nil
+}
+
+func ownerTeam(operation *openapi3.Operation) string {
+	if team, ok := operation.Extensions[ownerTeamExtension].(string); ok {
+		return team
+	}
+	return ""
+}
+
+func matchesStabilityLevel(version *apiversion.APIVersion, level string) bool {
+	switch {
+	case apiversion.IsPrivatePreviewStabilityLevel(level):
+		return version.IsPrivatePreview()
+	case apiversion.IsPublicPreviewStabilityLevel(level):
+		return version.IsPublicPreview()
+	case apiversion.IsUpcomingStabilityLevel(level):
+		return version.IsUpcoming()
+	default:
+		return version.IsStable()
+	}
+}
+
+// matchesPreviewName checks if the version is the private preview with the given name.
+// The name can be either the x-xgen-preview name or the private preview version, e.g. "private-preview-<name>".
+func matchesPreviewName(version *apiversion.APIVersion, name string) bool {
+	if !version.IsPrivatePreview() {
+		return false
+	}
+
+	previewName := strings.TrimPrefix(version.String(), apiversion.PrivatePreviewStabilityLevel+"-")
+	return strings.EqualFold(previewName, name) || strings.EqualFold(version.String(), name)
+}
+
+// sunsetDates returns the x-sunset dates declared in the operation and its versioned contents.
+func sunsetDates(operation *openapi3.Operation) []time.Time {
+	var dates []time.Time
+	if date, ok := parseSunset(operation.Extensions); ok {
+		dates = append(dates, date)
+	}
+
+	for _, c := range apiversion.ContentVersions(apiversion.OperationContents(operation)...) {
+		if date, ok := parseSunset(c.Content.Extensions); ok {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+// parseSunset parses the x-sunset extension, see sunset.ParseDate.
+func parseSunset(extensions map[string]any) (time.Time, bool) {
+	value, ok := extensions[sunsetExtension].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	date, err := sunset.ParseDate(value)
+	return date, err == nil
+}
+
+func isDateInRange(date time.Time, from, to *time.Time) bool {
+	if from != nil && date.Before(*from) {
+		return false
+	}
+
+	if to != nil && date.After(*to) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResponses(content openapi3.Content) *openapi3.Responses {
+	return openapi3.NewResponses(openapi3.WithStatus(200, &openapi3.ResponseRef{
+		Value: &openapi3.Response{Content: content},
+	}))
+}
+
+func newMetadataTestSpec() *openapi3.T {
+	oas := &openapi3.T{
+		Paths: openapi3.NewPaths(),
+	}
+
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/clusters", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "listClusters",
+			Extensions:  map[string]any{"x-xgen-owner-team": "Atlas Dedicated"},
+			Responses: newResponses(openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": {
+					Extensions: map[string]any{"x-sunset": "2026-01-22", "x-xgen-version": "2023-01-01"},
+				},
+				"application/vnd.atlas.2024-08-05+json": {
+					Extensions: map[string]any{"x-xgen-version": "2024-08-05"},
+				},
+			}),
+		},
+		Post: &openapi3.Operation{
+			OperationID: "createCluster",
+			Deprecated:  true,
+			Extensions:  map[string]any{"x-xgen-owner-team": "Atlas Dedicated"},
+			RequestBody: &openapi3.RequestBodyRef{
+				Value: &openapi3.RequestBody{
+					Content: openapi3.Content{
+						"application/vnd.atlas.2023-02-01+json": {
+							Extensions: map[string]any{"x-sunset": "2025-06-01T00:00:00Z"},
+						},
+					},
+				},
+			},
+		},
+	})
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/flexClusters", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "listFlexClusters",
+			Extensions:  map[string]any{"x-xgen-owner-team": "Atlas Serverless"},
+			Responses: newResponses(openapi3.Content{
+				"application/vnd.atlas.2025-01-01.upcoming+json": {},
+			}),
+		},
+	})
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/registry", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "getRegistry",
+			Responses: newResponses(openapi3.Content{
+				"application/vnd.atlas.preview+json": {
+					Extensions: map[string]any{"x-xgen-preview": map[string]any{"name": "api-registry"}},
+				},
+			}),
+		},
+		Post: &openapi3.Operation{
+			OperationID: "createRegistry",
+			Responses: newResponses(openapi3.Content{
+				"application/vnd.atlas.preview+json": {
+					Extensions: map[string]any{"x-xgen-preview": map[string]any{"public": "true"}},
+				},
+			}),
+		},
+	})
+	return oas
+}
+
+func newDate(t *testing.T, value string) *time.Time {
+	t.Helper()
+	date, err := time.Parse(time.DateOnly, value)
+	require.NoError(t, err)
+	return &date
+}
+
+func TestSlice_MetadataCriteria(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    func(t *testing.T) *Criteria
+		expectedIDs []string
+	}{
+		{
+			name: "owner team is case insensitive",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{OwnerTeams: []string{"atlas dedicated"}}
+			},
+			expectedIDs: []string{"listClusters", "createCluster"},
+		},
+		{
+			name: "deprecated",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{Deprecated: true}
+			},
+			expectedIDs: []string{"createCluster"},
+		},
+		{
+			name: "exclude deprecated",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{OwnerTeams: []string{"Atlas Dedicated"}, ExcludeDeprecated: true}
+			},
+			expectedIDs: []string{"listClusters"},
+		},
+		{
+			name: "upcoming stability level",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{StabilityLevels: []string{"upcoming"}}
+			},
+			expectedIDs: []string{"listFlexClusters"},
+		},
+		{
+			name: "preview stability levels",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{StabilityLevels: []string{"public-preview", "private-preview"}}
+			},
+			expectedIDs: []string{"getRegistry", "createRegistry"},
+		},
+		{
+			name: "exclude stable stability level",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{ExcludeStabilityLevels: []string{"stable"}}
+			},
+			expectedIDs: []string{"listFlexClusters", "getRegistry", "createRegistry"},
+		},
+		{
+			name: "private preview name",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{PreviewNames: []string{"api-registry"}}
+			},
+			expectedIDs: []string{"getRegistry"},
+		},
+		{
+			name: "private preview version",
+			criteria: func(*testing.T) *Criteria {
+				return &Criteria{PreviewNames: []string{"private-preview-api-registry"}}
+			},
+			expectedIDs: []string{"getRegistry"},
+		},
+		{
+			name: "sunset in range",
+			criteria: func(t *testing.T) *Criteria {
+				t.Helper()
+				return &Criteria{SunsetFrom: newDate(t, "2026-01-01"), SunsetTo: newDate(t, "2026-03-31")}
+			},
+			expectedIDs: []string{"listClusters"},
+		},
+		{
+			name: "sunset before date with timestamp",
+			criteria: func(t *testing.T) *Criteria {
+				t.Helper()
+				return &Criteria{SunsetTo: newDate(t, "2025-06-01")}
+			},
+			expectedIDs: []string{"createCluster"},
+		},
+		{
+			name: "owner team AND sunset",
+			criteria: func(t *testing.T) *Criteria {
+				t.Helper()
+				return &Criteria{
+					OwnerTeams: []string{"Atlas Dedicated"},
+					SunsetFrom: newDate(t, "2026-01-01"),
+					MatchAll:   true,
+				}
+			},
+			expectedIDs: []string{"listClusters"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oas := newMetadataTestSpec()
+
+			err := Slice(oas, tt.criteria(t))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedIDs, operationIDs(oas))
+		})
+	}
+}
+
+func TestSlice_InvalidStabilityLevel(t *testing.T) {
+	err := Slice(newMetadataTestSpec(), &Criteria{StabilityLevels: []string{"beta"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid stability level")
+}
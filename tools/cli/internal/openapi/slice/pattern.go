@@ -0,0 +1,102 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// RegexPathPrefix marks a path pattern as a regular expression, e.g. "regex:^/api/atlas/v2/groups/.*/clusters$".
+	RegexPathPrefix = "regex:"
+	globAnySegment  = "**"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
+
+// pathMatcher reports whether an OpenAPI path matches a pattern.
+type pathMatcher func(path string) bool
+
+// newPathMatcher compiles a path pattern into a pathMatcher.
+// Supported patterns:
+//   - Exact paths, compared after parameter normalization: /api/atlas/v2/groups/{groupId}
+//   - Glob patterns, where '*' matches within a single segment and '**' matches any number of segments:
+//     /api/atlas/v2/groups/{}/clusters/**
+//   - Regular expressions prefixed with "regex:", matched against the original path:
+//     regex:^/api/atlas/v2/groups/\{groupId\}/(clusters|flexClusters)$
+func newPathMatcher(pattern string) (pathMatcher, error) {
+	if expr, ok := strings.CutPrefix(pattern, RegexPathPrefix); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path regex %q: %w", expr, err)
+		}
+		return re.MatchString, nil
+	}
+
+	normalizedPattern := normalizePath(pattern)
+	if !strings.Contains(normalizedPattern, "*") {
+		return func(p string) bool {
+			return normalizePath(p) == normalizedPattern
+		}, nil
+	}
+
+	patternSegments := strings.Split(normalizedPattern, "/")
+	for _, segment := range patternSegments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, fmt.Errorf("invalid path glob %q: %w", pattern, err)
+		}
+	}
+
+	return func(p string) bool {
+		return matchGlobSegments(patternSegments, strings.Split(normalizePath(p), "/"))
+	}, nil
+}
+
+// matchGlobSegments matches path segments against glob segments.
+// The '**' segment matches zero or more path segments, any other segment is matched with path.Match.
+func matchGlobSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == globAnySegment {
+		for i := 0; i <= len(segments); i++ {
+			if matchGlobSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+		return false
+	}
+
+	return matchGlobSegments(pattern[1:], segments[1:])
+}
+
+// normalizePath replaces all path parameters with {} for comparison.
+// This allows matching paths with different parameter names.
+// Example: /api/v2/groups/{groupId} and /api/v2/groups/{id} both normalize to /api/v2/groups/{}.
+func normalizePath(p string) string {
+	return pathParamRegex.ReplaceAllString(p, "{}")
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPathMatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "exact path with different parameter names",
+			pattern:  "/api/atlas/v2/groups/{id}",
+			path:     "/api/atlas/v2/groups/{groupId}",
+			expected: true,
+		},
+		{
+			name:     "exact path does not match sub path",
+			pattern:  "/api/atlas/v2/groups/{id}",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters",
+			expected: false,
+		},
+		{
+			name:     "double star matches nested segments",
+			pattern:  "/api/atlas/v2/groups/{}/clusters/**",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/backup/snapshots",
+			expected: true,
+		},
+		{
+			name:     "double star matches zero segments",
+			pattern:  "/api/atlas/v2/groups/{}/clusters/**",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters",
+			expected: true,
+		},
+		{
+			name:     "double star in the middle",
+			pattern:  "/api/atlas/v2/**/snapshots",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/backup/snapshots",
+			expected: true,
+		},
+		{
+			name:     "single star matches one segment",
+			pattern:  "/api/atlas/v2/groups/*/clusters",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters",
+			expected: true,
+		},
+		{
+			name:     "single star does not match several segments",
+			pattern:  "/api/atlas/v2/*/clusters",
+			path:     "/api/atlas/v2/groups/{groupId}/clusters",
+			expected: false,
+		},
+		{
+			name:     "star within a segment",
+			pattern:  "/api/atlas/v2/groups/{}/flex*",
+			path:     "/api/atlas/v2/groups/{groupId}/flexClusters",
+			expected: true,
+		},
+		{
+			name:     "glob does not match other resources",
+			pattern:  "/api/atlas/v2/groups/{}/clusters/**",
+			path:     "/api/atlas/v2/groups/{groupId}/flexClusters",
+			expected: false,
+		},
+		{
+			name:     "regex matches original path",
+			pattern:  `regex:^/api/atlas/v2/groups/\{groupId\}/(clusters|flexClusters)$`,
+			path:     "/api/atlas/v2/groups/{groupId}/flexClusters",
+			expected: true,
+		},
+		{
+			name:     "regex does not match",
+			pattern:  `regex:^/api/atlas/v2/orgs`,
+			path:     "/api/atlas/v2/groups/{groupId}/flexClusters",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := newPathMatcher(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m(tt.path))
+		})
+	}
+}
+
+func TestNewPathMatcher_InvalidPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{
+			name:    "invalid regex",
+			pattern: "regex:/api/(groups",
+		},
+		{
+			name:    "invalid glob",
+			pattern: "/api/[groups/*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newPathMatcher(tt.pattern)
+			require.Error(t, err)
+		})
+	}
+}
@@ -16,29 +16,86 @@ package slice
 
 import (
 	"errors"
-	"regexp"
+	"fmt"
+	"net/http"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/mongodb/openapi/tools/cli/internal/openapi/filter"
 )
 
 // Criteria defines the selection criteria for slicing an OpenAPI spec.
-// Operations matching ANY of the specified criteria will be included (OR logic).
+// By default, operations matching ANY of the specified criteria will be included (OR logic).
+// When MatchAll is set, operations must match ALL of the specified criteria (AND logic).
+// Operations matching ANY of the exclusion criteria are always removed. If only exclusion
+// criteria are specified, every other operation is included.
 type Criteria struct {
-	OperationIDs []string // Match by operation ID
-	Tags         []string // Match by tag
-	Paths        []string // Match by path (supports parameter normalization)
+	OperationIDs    []string   // Match by operation ID
+	Tags            []string   // Match by tag
+	Paths           []string   // Match by path (supports parameter normalization, glob and regex patterns)
+	Methods         []string   // Match by HTTP method
+	OwnerTeams      []string   // Match by x-xgen-owner-team
+	Deprecated      bool       // Match deprecated operations
+	StabilityLevels []string   // Match by stability level of any content version
+	PreviewNames    []string   // Match by private preview name (x-xgen-preview) of any content version
+	SunsetFrom      *time.Time // Match operations with an x-sunset on or after this date
+	SunsetTo        *time.Time // Match operations with an x-sunset on or before this date
+
+	ExcludeOperationIDs    []string // Exclude by operation ID
+	ExcludeTags            []string // Exclude by tag
+	ExcludePaths           []string // Exclude by path (supports parameter normalization, glob and regex patterns)
+	ExcludeMethods         []string // Exclude by HTTP method
+	ExcludeOwnerTeams      []string // Exclude by x-xgen-owner-team
+	ExcludeDeprecated      bool     // Exclude deprecated operations
+	ExcludeStabilityLevels []string // Exclude by stability level of any content version
+	ExcludePreviewNames    []string // Exclude by private preview name (x-xgen-preview) of any content version
+
+	MatchAll bool // Match operations with ALL the criteria (AND logic) instead of ANY
+}
+
+// selection groups the values of either the inclusion or the exclusion criteria.
+type selection struct {
+	operationIDs    []string
+	tags            []string
+	paths           []string
+	methods         []string
+	ownerTeams      []string
+	deprecated      bool
+	stabilityLevels []string
+	previewNames    []string
+	sunsetFrom      *time.Time
+	sunsetTo        *time.Time
+}
+
+// operationMatcher reports whether an operation matches a single criterion.
+type operationMatcher func(path, method string, operation *openapi3.Operation) bool
+
+// selector holds the compiled criteria used to decide which operations are kept.
+type selector struct {
+	include  []operationMatcher
+	exclude  []operationMatcher
+	matchAll bool
 }
 
 // Slice creates a minispec containing only operations matching the criteria.
-// It removes non-matching operations and automatically cleans up unused tags and schemas.
-// This ensures the resulting spec is valid and contains no dangling references.
+// It removes non-matching operations and automatically cleans up unused tags and components.
+// It returns an error if the resulting spec contains dangling references.
 func Slice(spec *openapi3.T, criteria *Criteria) error {
 	if spec == nil {
 		return errors.New("OpenAPI spec is nil")
 	}
 
+	if criteria == nil {
+		return errors.New("slice criteria is nil")
+	}
+
+	s, err := newSelector(criteria)
+	if err != nil {
+		return err
+	}
+
 	if spec.Paths == nil {
 		return nil
 	}
@@ -52,7 +109,7 @@ func Slice(spec *openapi3.T, criteria *Criteria) error {
 			if operation == nil {
 				continue
 			}
-			if matches(path, operation, criteria) {
+			if s.matches(path, method, operation) {
 				hasOperations = true
 			} else {
 				pathItem.SetOperation(method, nil)
@@ -63,34 +120,124 @@ func Slice(spec *openapi3.T, criteria *Criteria) error {
 		}
 	}
 
-	filters := filter.FiltersToCleanupRefs(spec)
-	for _, f := range filters {
-		if err := f.Apply(); err != nil {
-			return err
+	return filter.CleanupRefs(spec)
+}
+
+// newSelector compiles the criteria into include and exclude matchers.
+func newSelector(criteria *Criteria) (*selector, error) {
+	include, err := newMatchers(&selection{
+		operationIDs:    criteria.OperationIDs,
+		tags:            criteria.Tags,
+		paths:           criteria.Paths,
+		methods:         criteria.Methods,
+		ownerTeams:      criteria.OwnerTeams,
+		deprecated:      criteria.Deprecated,
+		stabilityLevels: criteria.StabilityLevels,
+		previewNames:    criteria.PreviewNames,
+		sunsetFrom:      criteria.SunsetFrom,
+		sunsetTo:        criteria.SunsetTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := newMatchers(&selection{
+		operationIDs:    criteria.ExcludeOperationIDs,
+		tags:            criteria.ExcludeTags,
+		paths:           criteria.ExcludePaths,
+		methods:         criteria.ExcludeMethods,
+		ownerTeams:      criteria.ExcludeOwnerTeams,
+		deprecated:      criteria.ExcludeDeprecated,
+		stabilityLevels: criteria.ExcludeStabilityLevels,
+		previewNames:    criteria.ExcludePreviewNames,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &selector{
+		include:  include,
+		exclude:  exclude,
+		matchAll: criteria.MatchAll,
+	}, nil
+}
+
+// newMatchers returns one matcher per non-empty criterion of the selection.
+func newMatchers(sel *selection) ([]operationMatcher, error) {
+	var matchers []operationMatcher
+
+	if len(sel.paths) > 0 {
+		pathMatchers := make([]pathMatcher, 0, len(sel.paths))
+		for _, pattern := range sel.paths {
+			m, err := newPathMatcher(pattern)
+			if err != nil {
+				return nil, err
+			}
+			pathMatchers = append(pathMatchers, m)
 		}
+
+		matchers = append(matchers, func(path, _ string, _ *openapi3.Operation) bool {
+			return slices.ContainsFunc(pathMatchers, func(m pathMatcher) bool { return m(path) })
+		})
+	}
+
+	if len(sel.operationIDs) > 0 {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return slices.Contains(sel.operationIDs, operation.OperationID)
+		})
+	}
+
+	if len(sel.tags) > 0 {
+		matchers = append(matchers, func(_, _ string, operation *openapi3.Operation) bool {
+			return slices.ContainsFunc(sel.tags, func(tag string) bool { return slices.Contains(operation.Tags, tag) })
+		})
 	}
 
-	return nil
+	if len(sel.methods) > 0 {
+		for _, method := range sel.methods {
+			if !isHTTPMethod(method) {
+				return nil, fmt.Errorf("invalid HTTP method %q", method)
+			}
+		}
+
+		matchers = append(matchers, func(_, method string, _ *openapi3.Operation) bool {
+			return slices.ContainsFunc(sel.methods, func(m string) bool { return strings.EqualFold(m, method) })
+		})
+	}
+
+	metadataMatchers, err := newMetadataMatchers(sel)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(matchers, metadataMatchers...), nil
 }
 
-// matches checks if an operation matches any of the criteria (OR logic).
-func matches(path string, operation *openapi3.Operation, criteria *Criteria) bool {
-	// Check if the path matches (with normalization)
-	normalizedPath := normalizePath(path)
-	for _, pattern := range criteria.Paths {
-		if normalizedPath == normalizePath(pattern) {
-			return true
+// matches checks if an operation is selected by the criteria.
+// Exclusions take precedence over inclusions.
+func (s *selector) matches(path, method string, operation *openapi3.Operation) bool {
+	for _, m := range s.exclude {
+		if m(path, method, operation) {
+			return false
 		}
 	}
 
-	// Check if the operation ID matches
-	if slices.Contains(criteria.OperationIDs, operation.OperationID) {
+	if len(s.include) == 0 {
+		// Only exclusion criteria were provided, keep everything else
+		return len(s.exclude) > 0
+	}
+
+	if s.matchAll {
+		for _, m := range s.include {
+			if !m(path, method, operation) {
+				return false
+			}
+		}
 		return true
 	}
 
-	// Check if any tag matches
-	for _, tag := range criteria.Tags {
-		if slices.Contains(operation.Tags, tag) {
+	for _, m := range s.include {
+		if m(path, method, operation) {
 			return true
 		}
 	}
@@ -98,11 +245,16 @@ func matches(path string, operation *openapi3.Operation, criteria *Criteria) boo
 	return false
 }
 
-var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
-
-// normalizePath replaces all path parameters with {} for comparison.
-// This allows matching paths with different parameter names.
-// Example: /api/v2/groups/{groupId} and /api/v2/groups/{id} both normalize to /api/v2/groups/{}.
-func normalizePath(path string) string {
-	return pathParamRegex.ReplaceAllString(path, "{}")
+func isHTTPMethod(method string) bool {
+	return slices.Contains([]string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPost,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodDelete,
+		http.MethodConnect,
+		http.MethodOptions,
+		http.MethodTrace,
+	}, strings.ToUpper(method))
 }
@@ -318,3 +318,164 @@ func TestSlice_RemovePathWithNoMatchingOperations(t *testing.T) {
 	// Path should be removed since no operations match
 	assert.Equal(t, 0, oas.Paths.Len())
 }
+
+func newSliceTestSpec() *openapi3.T {
+	oas := &openapi3.T{
+		Paths: openapi3.NewPaths(),
+	}
+
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/clusters", &openapi3.PathItem{
+		Get:  &openapi3.Operation{OperationID: "listClusters", Tags: []string{"Clusters"}},
+		Post: &openapi3.Operation{OperationID: "createCluster", Tags: []string{"Clusters"}},
+	})
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/clusters/{clusterName}", &openapi3.PathItem{
+		Get:    &openapi3.Operation{OperationID: "getCluster", Tags: []string{"Clusters"}},
+		Delete: &openapi3.Operation{OperationID: "deleteCluster", Tags: []string{"Clusters"}},
+	})
+	oas.Paths.Set("/api/atlas/v2/groups/{groupId}/users", &openapi3.PathItem{
+		Get: &openapi3.Operation{OperationID: "listUsers", Tags: []string{"Users"}},
+	})
+	return oas
+}
+
+func operationIDs(oas *openapi3.T) []string {
+	ids := []string{}
+	for _, pathItem := range oas.Paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			ids = append(ids, operation.OperationID)
+		}
+	}
+	return ids
+}
+
+func TestSlice_AdvancedCriteria(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    *Criteria
+		expectedIDs []string
+	}{
+		{
+			name: "glob path pattern",
+			criteria: &Criteria{
+				Paths: []string{"/api/atlas/v2/groups/{}/clusters/**"},
+			},
+			expectedIDs: []string{"listClusters", "createCluster", "getCluster", "deleteCluster"},
+		},
+		{
+			name: "regex path pattern",
+			criteria: &Criteria{
+				Paths: []string{`regex:/clusters/\{clusterName\}$`},
+			},
+			expectedIDs: []string{"getCluster", "deleteCluster"},
+		},
+		{
+			name: "methods only",
+			criteria: &Criteria{
+				Methods: []string{"get"},
+			},
+			expectedIDs: []string{"listClusters", "getCluster", "listUsers"},
+		},
+		{
+			name: "OR logic across criteria",
+			criteria: &Criteria{
+				Tags:    []string{"Users"},
+				Methods: []string{"DELETE"},
+			},
+			expectedIDs: []string{"deleteCluster", "listUsers"},
+		},
+		{
+			name: "AND logic across criteria",
+			criteria: &Criteria{
+				Tags:     []string{"Clusters"},
+				Methods:  []string{"GET"},
+				MatchAll: true,
+			},
+			expectedIDs: []string{"listClusters", "getCluster"},
+		},
+		{
+			name: "exclusion only keeps everything else",
+			criteria: &Criteria{
+				ExcludeTags: []string{"Clusters"},
+			},
+			expectedIDs: []string{"listUsers"},
+		},
+		{
+			name: "exclusion takes precedence over inclusion",
+			criteria: &Criteria{
+				Paths:               []string{"/api/atlas/v2/groups/{}/clusters/**"},
+				ExcludeOperationIDs: []string{"deleteCluster"},
+				ExcludeMethods:      []string{"POST"},
+			},
+			expectedIDs: []string{"listClusters", "getCluster"},
+		},
+		{
+			name: "exclude paths",
+			criteria: &Criteria{
+				Tags:         []string{"Clusters", "Users"},
+				ExcludePaths: []string{"/api/atlas/v2/groups/{id}/clusters/*"},
+			},
+			expectedIDs: []string{"listClusters", "createCluster", "listUsers"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oas := newSliceTestSpec()
+
+			err := Slice(oas, tt.criteria)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedIDs, operationIDs(oas))
+		})
+	}
+}
+
+func TestSlice_InvalidCriteria(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria *Criteria
+		errorMsg string
+	}{
+		{
+			name:     "nil criteria",
+			criteria: nil,
+			errorMsg: "slice criteria is nil",
+		},
+		{
+			name:     "invalid method",
+			criteria: &Criteria{Methods: []string{"FETCH"}},
+			errorMsg: `invalid HTTP method "FETCH"`,
+		},
+		{
+			name:     "invalid exclude path regex",
+			criteria: &Criteria{ExcludePaths: []string{"regex:("}},
+			errorMsg: "invalid path regex",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Slice(newSliceTestSpec(), tt.criteria)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
+func TestSlice_DanglingRefs(t *testing.T) {
+	oas := &openapi3.T{
+		Paths: openapi3.NewPaths(),
+	}
+
+	oas.Paths.Set("/api/v2/groups/{groupId}", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "getGroup",
+			Parameters: openapi3.Parameters{
+				{Ref: "#/components/parameters/groupId"},
+			},
+		},
+	})
+
+	err := Slice(oas, &Criteria{OperationIDs: []string{"getGroup"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "#/components/parameters/groupId")
+}
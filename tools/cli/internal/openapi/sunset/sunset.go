@@ -15,10 +15,12 @@
 package sunset
 
 import (
+	"fmt"
 	"maps"
 	"regexp"
 	"slices"
 	"sort"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/load"
@@ -75,6 +77,22 @@ func NewListFromSpec(spec *load.SpecInfo) []*Sunset {
 	return sunsets
 }
 
+// ParseDate parses an x-sunset value, which is either a date (YYYY-MM-DD) or a RFC3339 timestamp.
+// Timestamps are truncated to their UTC date.
+func ParseDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be a date (YYYY-MM-DD) or a RFC3339 timestamp, got %q", sunsetExtensionName, value)
+	}
+
+	year, month, day := date.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
+
 func teamName(op *openapi3.Operation) string {
 	if value, ok := op.Extensions[teamExtensionName]; ok {
 		return value.(string)
@@ -2,10 +2,12 @@ package sunset
 
 import (
 	"testing"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/load"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewSunsetListFromSpec(t *testing.T) {
@@ -172,3 +174,18 @@ func TestNewExtensionsFrom2xxResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDate(t *testing.T) {
+	expected := time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC)
+
+	date, err := ParseDate("2025-08-05")
+	require.NoError(t, err)
+	assert.Equal(t, expected, date)
+
+	date, err = ParseDate("2025-08-05T22:00:00-03:00")
+	require.NoError(t, err)
+	assert.Equal(t, expected.AddDate(0, 0, 1), date)
+
+	_, err = ParseDate("next year")
+	require.EqualError(t, err, `x-sunset must be a date (YYYY-MM-DD) or a RFC3339 timestamp, got "next year"`)
+}
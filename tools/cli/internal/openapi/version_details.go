@@ -0,0 +1,147 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mongodb/openapi/tools/cli/internal/apiversion"
+)
+
+const privatePreviewPrefix = apiversion.PrivatePreviewStabilityLevel + "-"
+
+// VersionDetails is the metadata of an API version.
+type VersionDetails struct {
+	Version              string   `json:"version" yaml:"version"`
+	StabilityLevel       string   `json:"stabilityLevel" yaml:"stabilityLevel"`
+	ReleaseDate          string   `json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+	PreviewName          string   `json:"previewName,omitempty" yaml:"previewName,omitempty"`
+	OperationsIntroduced int      `json:"operationsIntroduced" yaml:"operationsIntroduced"`
+	OperationsUpdated    int      `json:"operationsUpdated" yaml:"operationsUpdated"`
+	EarliestSunset       string   `json:"earliestSunset,omitempty" yaml:"earliestSunset,omitempty"`
+	LatestSunset         string   `json:"latestSunset,omitempty" yaml:"latestSunset,omitempty"`
+	Environments         []string `json:"environments" yaml:"environments"`
+}
+
+// NewVersionDetails returns the metadata of the given versions.
+// An operation is introduced in the oldest version of its content, and updated in each of the following versions.
+// The environments are the ones, among envs, where the version is visible.
+func NewVersionDetails(oas *openapi3.T, versions, envs []string) ([]*VersionDetails, error) {
+	details := make(map[string]*VersionDetails, len(versions))
+	out := make([]*VersionDetails, 0, len(versions))
+	for _, version := range versions {
+		d, err := newVersionDetails(version)
+		if err != nil {
+			return nil, err
+		}
+		details[version] = d
+		out = append(out, d)
+	}
+
+	for _, pathItem := range oas.Paths.Map() {
+		if pathItem == nil {
+			continue
+		}
+		for _, operation := range pathItem.Operations() {
+			if operation != nil {
+				addOperationDetails(operation, details)
+			}
+		}
+	}
+
+	for _, env := range envs {
+		envVersions, err := ExtractVersionsWithEnv(oas, env)
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range envVersions {
+			if d, ok := details[version]; ok {
+				d.Environments = append(d.Environments, env)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func newVersionDetails(version string) (*VersionDetails, error) {
+	apiVersion, err := apiversion.New(apiversion.WithVersion(version))
+	if err != nil {
+		return nil, err
+	}
+
+	d := &VersionDetails{
+		Version:        version,
+		StabilityLevel: apiVersion.StabilityLevel(),
+		Environments:   []string{},
+	}
+
+	switch {
+	case apiVersion.IsPrivatePreview():
+		d.StabilityLevel = apiversion.PrivatePreviewStabilityLevel
+		d.PreviewName = strings.TrimPrefix(version, privatePreviewPrefix)
+	case apiVersion.IsPublicPreview():
+		d.StabilityLevel = apiversion.PublicPreviewStabilityLevel
+	default:
+		d.ReleaseDate = apiVersion.Date().Format(time.DateOnly)
+	}
+
+	return d, nil
+}
+
+// addOperationDetails adds the operation to the introduced or updated count, and its sunset dates, of each
+// version of its contents, see apiversion.OperationContentVersions.
+func addOperationDetails(operation *openapi3.Operation, details map[string]*VersionDetails) {
+	var contentVersions []*apiversion.APIVersion
+	sunsets := make(map[string][]string)
+	for _, content := range apiversion.OperationContentVersions(operation) {
+		if !slices.ContainsFunc(contentVersions, content.Version.Equal) {
+			contentVersions = append(contentVersions, content.Version)
+		}
+		if content.Content == nil {
+			continue
+		}
+		if sunset, ok := content.Content.Extensions[sunsetExtension].(string); ok {
+			sunsets[content.Version.String()] = append(sunsets[content.Version.String()], sunset)
+		}
+	}
+
+	// Sort sorts in descending order, so the oldest version is the last one
+	apiversion.Sort(contentVersions)
+	for i, contentVersion := range contentVersions {
+		d, ok := details[contentVersion.String()]
+		if !ok {
+			continue
+		}
+
+		if i == len(contentVersions)-1 {
+			d.OperationsIntroduced++
+		} else {
+			d.OperationsUpdated++
+		}
+
+		for _, sunset := range sunsets[contentVersion.String()] {
+			if d.EarliestSunset == "" || sunset < d.EarliestSunset {
+				d.EarliestSunset = sunset
+			}
+			if sunset > d.LatestSunset {
+				d.LatestSunset = sunset
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionDetails(t *testing.T) {
+	oas := NewVersionedResponses(t)
+
+	// pathBase1 is introduced in 2023-01-01 and updated in 2023-02-01
+	content := oas.Paths.Find("pathBase1").Get.Responses.Value("200").Value.Content
+	content["application/vnd.atlas.2023-01-01+json"] = &openapi3.MediaType{Extensions: map[string]any{"x-sunset": "2026-01-01"}}
+	content["application/vnd.atlas.2023-02-01+json"] = &openapi3.MediaType{}
+
+	versions := []string{"2023-01-01", "2023-02-01", "preview", "private-preview-info-resource"}
+	details, err := NewVersionDetails(oas, versions, []string{"dev", "qa", "prod"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*VersionDetails{
+		{
+			Version:              "2023-01-01",
+			StabilityLevel:       "stable",
+			ReleaseDate:          "2023-01-01",
+			OperationsIntroduced: 1,
+			EarliestSunset:       "2026-01-01",
+			LatestSunset:         "2026-01-01",
+			Environments:         []string{"dev", "qa", "prod"},
+		},
+		{
+			Version:              "2023-02-01",
+			StabilityLevel:       "stable",
+			ReleaseDate:          "2023-02-01",
+			OperationsIntroduced: 1,
+			OperationsUpdated:    1,
+			Environments:         []string{"dev", "qa", "prod"},
+		},
+		{
+			Version:              "preview",
+			StabilityLevel:       "public-preview",
+			OperationsIntroduced: 1,
+			Environments:         []string{"dev", "qa"},
+		},
+		{
+			Version:              "private-preview-info-resource",
+			StabilityLevel:       "private-preview",
+			PreviewName:          "info-resource",
+			OperationsIntroduced: 1,
+			Environments:         []string{"dev"},
+		},
+	}, details)
+}
+
+func TestNewVersionDetails_InvalidVersion(t *testing.T) {
+	_, err := NewVersionDetails(NewVersionedResponses(t), []string{"not-a-version"}, nil)
+	require.Error(t, err)
+}
+
+func TestAddOperationDetails_RequestBodyVersions(t *testing.T) {
+	details := map[string]*VersionDetails{
+		"2023-01-01": {Version: "2023-01-01"},
+		"2023-02-01": {Version: "2023-02-01"},
+	}
+	operation := &openapi3.Operation{
+		RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{
+			Content: openapi3.Content{
+				"application/vnd.atlas.2023-01-01+json": {Extensions: map[string]any{"x-sunset": "2026-01-01"}},
+				"application/vnd.atlas.2023-02-01+json": {},
+			},
+		}},
+		Responses: openapi3.NewResponses(),
+	}
+
+	addOperationDetails(operation, details)
+
+	assert.Equal(t, map[string]*VersionDetails{
+		"2023-01-01": {Version: "2023-01-01", OperationsIntroduced: 1, EarliestSunset: "2026-01-01", LatestSunset: "2026-01-01"},
+		"2023-02-01": {Version: "2023-02-01", OperationsUpdated: 1},
+	}, details)
+}
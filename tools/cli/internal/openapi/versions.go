@@ -42,6 +42,8 @@ func ExtractVersionsWithEnv(oas *openapi3.T, env string) ([]string, error) {
 }
 
 // extractVersions extracts version strings from an OpenAPI specification.
+// The versions are extracted from the response and request body content types. Operations without
+// versioned content types contribute the version declared by their x-xgen-version extension.
 func extractVersions(oas *openapi3.T) ([]string, error) {
 	versions := make(map[string]struct{})
 	for _, pathItem := range oas.Paths.Map() {
@@ -52,15 +54,15 @@ func extractVersions(oas *openapi3.T) ([]string, error) {
 			if op == nil {
 				continue
 			}
-			for _, response := range op.Responses.Map() {
-				if response.Value == nil || response.Value.Content == nil {
-					continue
-				}
-				for contentType, contentTypeValue := range response.Value.Content {
+
+			versioned := false
+			for _, content := range apiversion.OperationContents(op) {
+				for contentType, contentTypeValue := range content {
 					version, err := apiversion.Parse(contentType)
 					if err != nil {
 						continue
 					}
+					versioned = true
 
 					if apiversion.IsPreviewStabilityLevel(version) {
 						// parse if it is public or not
@@ -74,6 +76,14 @@ func extractVersions(oas *openapi3.T) ([]string, error) {
 					versions[version] = struct{}{}
 				}
 			}
+
+			if versioned {
+				continue
+			}
+
+			if version, ok := apiversion.OperationVersion(op); ok {
+				versions[version.String()] = struct{}{}
+			}
 		}
 	}
 
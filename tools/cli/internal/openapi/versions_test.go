@@ -65,6 +65,42 @@ func TestVersions_InvalidPreviewData(t *testing.T) {
 	require.ErrorContains(t, err, "nvalid value for 'public' field")
 }
 
+func TestVersions_RequestBodyAndVersionExtension(t *testing.T) {
+	paths := &openapi3.Paths{}
+
+	responses := &openapi3.Responses{}
+	responses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+	paths.Set("pathBase1", &openapi3.PathItem{
+		Patch: &openapi3.Operation{
+			Responses: responses,
+			RequestBody: &openapi3.RequestBodyRef{
+				Value: &openapi3.RequestBody{
+					Content: map[string]*openapi3.MediaType{
+						"application/vnd.atlas.2023-01-01+json": {},
+					},
+				},
+			},
+		},
+	})
+
+	unversionedResponses := &openapi3.Responses{}
+	unversionedResponses.Set("200", &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Content: map[string]*openapi3.MediaType{"application/json": {}},
+		},
+	})
+	paths.Set("pathBase2", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			Responses:  unversionedResponses,
+			Extensions: map[string]any{"x-xgen-version": "2024-01-01"},
+		},
+	})
+
+	versions, err := ExtractVersionsWithEnv(&openapi3.T{Paths: paths}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2023-01-01", "2024-01-01"}, versions)
+}
+
 func NewVersionedResponses(t *testing.T) *openapi3.T {
 	t.Helper()
 	inputPath := &openapi3.Paths{}